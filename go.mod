@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.53.6
-	github.com/aws/aws-sdk-go-v2 v1.27.0
+	github.com/aws/aws-sdk-go-v2 v1.30.3
 	github.com/aws/aws-sdk-go-v2/config v1.27.15
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.15
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.3
@@ -33,7 +33,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.20.8
 	github.com/aws/aws-sdk-go-v2/service/batch v1.37.3
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.3.7
-	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.4
+	github.com/aws/aws-sdk-go-v2/service/bedrock v1.12.0
 	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.11.0
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.3
 	github.com/aws/aws-sdk-go-v2/service/chatbot v1.1.8
@@ -182,7 +182,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.3
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.3
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.7
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.20.3
 	github.com/beevik/etree v1.4.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -234,8 +234,8 @@ require (
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.7 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.7 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.15 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.15 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.7 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
@@ -4,7 +4,9 @@
 package conns
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"maps"
 	"net/http"
@@ -16,6 +18,7 @@ import (
 	config_sdkv2 "github.com/aws/aws-sdk-go-v2/config"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	s3_sdkv2 "github.com/aws/aws-sdk-go-v2/service/s3"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	directoryservice_sdkv1 "github.com/aws/aws-sdk-go/service/directoryservice"
@@ -36,19 +39,21 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	awsConfig                   *aws_sdkv2.Config
+	clients                     map[string]any
+	conns                       map[string]any
+	decodeAuthorizationMessages bool // From provider configuration.
+	dnsSuffix                   string
+	endpoints                   map[string]string // From provider configuration.
+	httpClient                  *http.Client
+	lock                        sync.Mutex
+	logger                      baselogging.Logger
+	reportDrift                 bool // From provider configuration.
+	session                     *session_sdkv1.Session
+	s3ExpressClient             *s3_sdkv2.Client
+	s3UsePathStyle              bool   // From provider configuration.
+	s3USEast1RegionalEndpoint   string // From provider configuration.
+	stsRegion                   string // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -139,6 +144,39 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 	return c.s3ExpressClient
 }
 
+// DecodeAuthorizationMessages returns the decode_authorization_messages provider configuration value.
+func (c *AWSClient) DecodeAuthorizationMessages(context.Context) bool {
+	return c.decodeAuthorizationMessages
+}
+
+// DecodeAuthorizationMessage decodes an encoded authorization failure message using the STS DecodeAuthorizationMessage API.
+// The decoded message is returned as indented JSON.
+func (c *AWSClient) DecodeAuthorizationMessage(ctx context.Context, encoded string) (string, error) {
+	input := &sts_sdkv2.DecodeAuthorizationMessageInput{
+		EncodedMessage: aws_sdkv2.String(encoded),
+	}
+
+	output, err := c.STSClient(ctx).DecodeAuthorizationMessage(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("decoding authorization message: %w", err)
+	}
+
+	decoded := aws_sdkv2.ToString(output.DecodedMessage)
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(decoded), "", "  "); err != nil {
+		return decoded, nil //nolint:nilerr // Return the message as-is.
+	}
+
+	return buf.String(), nil
+}
+
+// ReportDrift returns the report_drift provider configuration value.
+func (c *AWSClient) ReportDrift(context.Context) bool {
+	return c.reportDrift
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -10,6 +10,7 @@ import (
 
 	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -78,6 +79,7 @@ func diagError(service, action, resource, id string, gotError error) diag.Diagno
 	return diag.Diagnostic{
 		Severity: diag.Error,
 		Summary:  ProblemStandardMessage(service, action, resource, id, gotError),
+		Detail:   errs.APIErrorDetail(gotError),
 	}
 }
 
@@ -114,6 +116,6 @@ func LogNotFoundRemoveState(service, action, resource, id string) {
 func DiagErrorFramework(service, action, resource, id string, gotError error) fwdiag.Diagnostic {
 	return fwdiag.NewErrorDiagnostic(
 		ProblemStandardMessage(service, action, resource, id, nil),
-		gotError.Error(),
+		errs.AppendDetail(gotError.Error(), errs.APIErrorDetail(gotError)),
 	)
 }
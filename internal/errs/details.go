@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+)
+
+// APIErrorDetails are the details of a failed AWS API call.
+type APIErrorDetails struct {
+	Service        string // e.g. "EC2". AWS SDK for Go v2 only.
+	Operation      string // e.g. "RunInstances". AWS SDK for Go v2 only.
+	Code           string
+	Message        string
+	HTTPStatusCode int
+	RequestID      string
+}
+
+// NewAPIErrorDetails returns the details of the AWS API error in err's tree.
+// The second return value is false if err is not an AWS API error.
+func NewAPIErrorDetails(err error) (APIErrorDetails, bool) {
+	var details APIErrorDetails
+
+	if err == nil {
+		return details, false
+	}
+
+	// AWS SDK for Go v2.
+	if v, ok := As[*smithy.OperationError](err); ok {
+		details.Service = v.Service()
+		details.Operation = v.Operation()
+	}
+	if v, ok := As[*awshttp.ResponseError](err); ok {
+		details.HTTPStatusCode = v.HTTPStatusCode()
+		details.RequestID = v.ServiceRequestID()
+	}
+	if v, ok := As[smithy.APIError](err); ok {
+		details.Code = v.ErrorCode()
+		details.Message = v.ErrorMessage()
+
+		return details, true
+	}
+
+	// AWS SDK for Go v1.
+	if v, ok := As[awserr.RequestFailure](err); ok {
+		details.Code = v.Code()
+		details.Message = v.Message()
+		details.HTTPStatusCode = v.StatusCode()
+		details.RequestID = v.RequestID()
+
+		return details, true
+	}
+	if v, ok := As[awserr.Error](err); ok {
+		details.Code = v.Code()
+		details.Message = v.Message()
+
+		return details, true
+	}
+
+	return details, false
+}
+
+var (
+	// https://docs.aws.amazon.com/STS/latest/APIReference/API_DecodeAuthorizationMessage.html.
+	encodedAuthorizationMessageRegexp = regexp.MustCompile(`Encoded authorization failure message: ([0-9A-Za-z_-]+)`)
+)
+
+// EncodedAuthorizationMessage returns the encoded authorization failure message,
+// returned by e.g. EC2 in UnauthorizedOperation errors, contained in s.
+// An empty string is returned if s contains no encoded message.
+func EncodedAuthorizationMessage(s string) string {
+	if m := encodedAuthorizationMessageRegexp.FindStringSubmatch(s); len(m) == 2 {
+		return m[1]
+	}
+
+	return ""
+}
+
+// Hint returns a remediation hint for common error codes.
+func (d APIErrorDetails) Hint() string {
+	switch d.Code {
+	case "AccessDenied", "AccessDeniedException":
+		return "The caller's credentials are not authorized to perform this operation. " +
+			"Check the identity-based and resource-based policies, permissions boundaries, session policies and service control policies that apply to the caller."
+	case "UnauthorizedOperation":
+		if EncodedAuthorizationMessage(d.Message) != "" {
+			return "The caller's credentials are not authorized to perform this operation. " +
+				"Decode the encoded authorization failure message with `aws sts decode-authorization-message` " +
+				"or set the provider's `decode_authorization_messages` argument to decode it automatically (requires `sts:DecodeAuthorizationMessage` permission)."
+		}
+		return "The caller's credentials are not authorized to perform this operation."
+	case "LimitExceeded", "LimitExceededException", "ServiceQuotaExceededException":
+		return "A service quota has been reached. " +
+			"Delete unused resources or request a quota increase using Service Quotas or AWS Support."
+	case "InvalidClientTokenId", "UnrecognizedClientException":
+		return "The access key ID is not valid in this partition or Region, or has been deleted. " +
+			"Check the provider's credentials, and that any opt-in Region or STS endpoint in use is enabled for the account."
+	case "ExpiredToken", "ExpiredTokenException":
+		return "The session token has expired. Refresh the provider's credentials."
+	}
+
+	return ""
+}
+
+// String returns a multi-line description of the details, suitable for a diagnostic's detail.
+func (d APIErrorDetails) String() string {
+	var lines []string
+
+	if d.Service != "" || d.Operation != "" {
+		lines = append(lines, fmt.Sprintf("Service: %s, Operation: %s", d.Service, d.Operation))
+	}
+
+	var fields []string
+	if d.Code != "" {
+		fields = append(fields, "Error code: "+d.Code)
+	}
+	if d.HTTPStatusCode != 0 {
+		fields = append(fields, fmt.Sprintf("HTTP status: %d", d.HTTPStatusCode))
+	}
+	if d.RequestID != "" {
+		fields = append(fields, "Request ID: "+d.RequestID)
+	}
+	if len(fields) > 0 {
+		lines = append(lines, strings.Join(fields, ", "))
+	}
+
+	if hint := d.Hint(); hint != "" {
+		lines = append(lines, "Hint: "+hint)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// APIErrorDetail returns a diagnostic detail describing the AWS API error
+// in the tree of any of the errors in a.
+// An empty string is returned if there is none.
+func APIErrorDetail(a ...any) string {
+	for _, v := range a {
+		if err, ok := v.(error); ok {
+			if details, ok := NewAPIErrorDetails(err); ok {
+				return details.String()
+			}
+		}
+	}
+
+	return ""
+}
+
+// AppendDetail appends an additional paragraph to a diagnostic's detail.
+func AppendDetail(detail, s string) string {
+	switch {
+	case s == "":
+		return detail
+	case detail == "":
+		return s
+	default:
+		return detail + "\n\n" + s
+	}
+}
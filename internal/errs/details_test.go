@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package errs_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func TestNewAPIErrorDetails(t *testing.T) {
+	t.Parallel()
+
+	sdkv2Err := &smithy.OperationError{
+		ServiceID:     "EC2",
+		OperationName: "RunInstances",
+		Err: &awshttp.ResponseError{
+			ResponseError: &smithyhttp.ResponseError{
+				Response: &smithyhttp.Response{Response: &http.Response{StatusCode: http.StatusForbidden}},
+				Err:      errs.APIError("UnauthorizedOperation", "You are not authorized to perform this operation. Encoded authorization failure message: abc-DEF_123"),
+			},
+			RequestID: "1234",
+		},
+	}
+
+	testCases := map[string]struct {
+		err      error
+		expected errs.APIErrorDetails
+		ok       bool
+	}{
+		"nil": {},
+		"not API error": {
+			err: errors.New("test"),
+		},
+		"SDK v2": {
+			err: fmt.Errorf("creating EC2 Instance: %w", sdkv2Err),
+			expected: errs.APIErrorDetails{
+				Service:        "EC2",
+				Operation:      "RunInstances",
+				Code:           "UnauthorizedOperation",
+				Message:        "You are not authorized to perform this operation. Encoded authorization failure message: abc-DEF_123",
+				HTTPStatusCode: http.StatusForbidden,
+				RequestID:      "1234",
+			},
+			ok: true,
+		},
+		"SDK v1 request failure": {
+			err: awserr.NewRequestFailure(awserr.New("LimitExceeded", "Cannot exceed quota", nil), http.StatusBadRequest, "5678"),
+			expected: errs.APIErrorDetails{
+				Code:           "LimitExceeded",
+				Message:        "Cannot exceed quota",
+				HTTPStatusCode: http.StatusBadRequest,
+				RequestID:      "5678",
+			},
+			ok: true,
+		},
+		"SDK v1 error": {
+			err: awserr.New("AccessDenied", "Access Denied", nil),
+			expected: errs.APIErrorDetails{
+				Code:    "AccessDenied",
+				Message: "Access Denied",
+			},
+			ok: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := errs.NewAPIErrorDetails(testCase.err)
+
+			if ok != testCase.ok {
+				t.Errorf("ok = %t, want %t", ok, testCase.ok)
+			}
+			if got != testCase.expected {
+				t.Errorf("details = %+v, want %+v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDetailsString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		details  errs.APIErrorDetails
+		expected string
+	}{
+		"empty": {},
+		"all fields": {
+			details: errs.APIErrorDetails{
+				Service:        "IAM",
+				Operation:      "CreateRole",
+				Code:           "LimitExceeded",
+				HTTPStatusCode: http.StatusConflict,
+				RequestID:      "1234",
+			},
+			expected: "Service: IAM, Operation: CreateRole\n" +
+				"Error code: LimitExceeded, HTTP status: 409, Request ID: 1234\n" +
+				"Hint: A service quota has been reached. Delete unused resources or request a quota increase using Service Quotas or AWS Support.",
+		},
+		"no hint": {
+			details: errs.APIErrorDetails{
+				Code: "ValidationException",
+			},
+			expected: "Error code: ValidationException",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.details.String(), testCase.expected; got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodedAuthorizationMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s        string
+		expected string
+	}{
+		"empty": {},
+		"no encoded message": {
+			s: "operation error EC2: RunInstances, api error UnauthorizedOperation: You are not authorized to perform this operation.",
+		},
+		"encoded message": {
+			s:        "api error UnauthorizedOperation: You are not authorized to perform this operation. Encoded authorization failure message: abc-DEF_123\n\nmore",
+			expected: "abc-DEF_123",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := errs.EncodedAuthorizationMessage(testCase.s), testCase.expected; got != want {
+				t.Errorf("EncodedAuthorizationMessage() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAPIErrorDetail(t *testing.T) {
+	t.Parallel()
+
+	if got := errs.APIErrorDetail("id", errors.New("test")); got != "" {
+		t.Errorf("APIErrorDetail() = %q, want empty", got)
+	}
+
+	err := awserr.NewRequestFailure(awserr.New("InvalidClientTokenId", "The security token included in the request is invalid.", nil), http.StatusForbidden, "5678")
+	if got, want := errs.APIErrorDetail("id", err), "Error code: InvalidClientTokenId, HTTP status: 403, Request ID: 5678\nHint: "; len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("APIErrorDetail() = %q, want prefix %q", got, want)
+	}
+}
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/servicevalidators/model"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+const (
+	defaultFilename = "validators_gen.go"
+	sdkV1Module     = "github.com/aws/aws-sdk-go"
+)
+
+var (
+	modelsDir  = flag.String("ModelsDir", "", "directory of the AWS API models (default: models/apis in the AWS SDK for Go module)")
+	operations = flag.String("Operations", "", "comma-separated list of operations whose input members are validated")
+	report     = flag.Bool("Report", false, "report arguments lacking validation instead of generating code")
+	sdkV2      = flag.Bool("SDKv2", false, "generate Terraform Plugin SDK V2 validate functions instead of Terraform Plugin Framework validators")
+	shapes     = flag.String("Shapes", "", "comma-separated list of structure shapes whose members are validated")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-validators-file>]\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -Report [<service-directory>...]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	g := common.NewGenerator()
+
+	if *modelsDir == "" {
+		dir, err := sdkModelsDir()
+		if err != nil {
+			g.Fatalf("locating AWS API models: %s", err)
+		}
+		*modelsDir = dir
+	}
+
+	if *report {
+		dirs := flag.Args()
+		if len(dirs) == 0 {
+			dirs = []string{"."}
+		}
+		if err := reportCoverage(g, dirs); err != nil {
+			g.Fatalf("%s", err)
+		}
+		return
+	}
+
+	filename := defaultFilename
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	m, err := loadModel(servicePackage)
+	if err != nil {
+		g.Fatalf("%s", err)
+	}
+
+	td := TemplateData{
+		ProviderPackage: servicePackage,
+		SDKv2:           *sdkV2,
+	}
+
+	var sources []source
+	for _, op := range split(*operations) {
+		shape, err := m.InputShape(op)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+		sources = append(sources, source{prefix: op, shape: shape})
+	}
+	for _, shape := range split(*shapes) {
+		sources = append(sources, source{prefix: shape, shape: shape})
+	}
+	if len(sources) == 0 {
+		g.Fatalf("at least one of -Operations or -Shapes is required")
+	}
+
+	for _, src := range sources {
+		members, err := m.Members(src.shape)
+		if err != nil {
+			g.Fatalf("%s", err)
+		}
+
+		for _, member := range members {
+			if member.Constraints.UnsupportedPattern != "" {
+				g.Warnf("%s.%s: pattern %q is not supported by Go regular expressions", src.prefix, member.Name, member.Constraints.UnsupportedPattern)
+			}
+
+			v := td.validator(src.prefix, member)
+			if v == nil {
+				continue
+			}
+			td.Validators = append(td.Validators, *v)
+		}
+	}
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("validators", tmpl, td); err != nil {
+		g.Fatalf("generating %s validators: %s", servicePackage, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+type source struct {
+	prefix string // Generated function name prefix.
+	shape  string
+}
+
+type TemplateData struct {
+	ProviderPackage string
+	SDKv2           bool
+	Validators      []ValidatorDatum
+
+	ImportFWValidator bool
+	ImportInt64       bool
+	ImportList        bool
+	ImportMath        bool
+	ImportRegexache   bool
+	ImportString      bool
+}
+
+type ValidatorDatum struct {
+	FuncName    string
+	Member      string // e.g. "CreateAgent.agentName".
+	Shape       string
+	Type        string // Framework validator type, e.g. "String".
+	Expressions []string
+}
+
+// validator returns the validator for the member, or nil if the member has no
+// constraints checkable with the generated kind of validator.
+func (td *TemplateData) validator(prefix string, member model.Member) *ValidatorDatum {
+	c := member.Constraints
+	if c.IsEmpty() {
+		return nil
+	}
+
+	v := &ValidatorDatum{
+		Member: prefix + "." + member.Name,
+		Shape:  member.Shape,
+	}
+
+	if td.SDKv2 {
+		v.FuncName = lowerFirst(prefix) + goName(member.Name) + "ValidateFunc"
+		v.Expressions = td.sdkExpressions(c)
+	} else {
+		v.FuncName = lowerFirst(prefix) + goName(member.Name) + "Validators"
+		v.Type, v.Expressions = td.frameworkExpressions(c)
+	}
+
+	if len(v.Expressions) == 0 {
+		return nil
+	}
+
+	return v
+}
+
+func (td *TemplateData) frameworkExpressions(c model.Constraints) (string, []string) {
+	var typ, pkg, between, atLeast, atMost string
+	var exprs []string
+
+	switch c.Type {
+	case "string":
+		td.ImportString = true
+		typ, pkg, between, atLeast, atMost = "String", "stringvalidator", "LengthBetween", "LengthAtLeast", "LengthAtMost"
+	case "integer", "long":
+		td.ImportInt64 = true
+		typ, pkg, between, atLeast, atMost = "Int64", "int64validator", "Between", "AtLeast", "AtMost"
+	case "list":
+		td.ImportList = true
+		typ, pkg, between, atLeast, atMost = "List", "listvalidator", "SizeBetween", "SizeAtLeast", "SizeAtMost"
+	default:
+		return "", nil
+	}
+	td.ImportFWValidator = true
+
+	switch {
+	case c.Min != nil && c.Max != nil:
+		exprs = append(exprs, fmt.Sprintf("%s.%s(%d, %d)", pkg, between, *c.Min, *c.Max))
+	case c.Min != nil:
+		exprs = append(exprs, fmt.Sprintf("%s.%s(%d)", pkg, atLeast, *c.Min))
+	case c.Max != nil:
+		exprs = append(exprs, fmt.Sprintf("%s.%s(%d)", pkg, atMost, *c.Max))
+	}
+
+	if c.Type == "string" {
+		if c.Pattern != "" {
+			td.ImportRegexache = true
+			exprs = append(exprs, fmt.Sprintf(`stringvalidator.RegexMatches(regexache.MustCompile(%s), "")`, quote(c.Pattern)))
+		}
+		if len(c.Enum) > 0 {
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.OneOf(%s)", quoteAll(c.Enum)))
+		}
+	}
+
+	return typ, exprs
+}
+
+func (td *TemplateData) sdkExpressions(c model.Constraints) []string {
+	var exprs []string
+
+	switch c.Type {
+	case "string":
+		switch {
+		case c.Min != nil && c.Max != nil:
+			exprs = append(exprs, fmt.Sprintf("validation.StringLenBetween(%d, %d)", *c.Min, *c.Max))
+		case c.Min != nil:
+			td.ImportMath = true
+			exprs = append(exprs, fmt.Sprintf("validation.StringLenBetween(%d, math.MaxInt)", *c.Min))
+		case c.Max != nil:
+			exprs = append(exprs, fmt.Sprintf("validation.StringLenBetween(0, %d)", *c.Max))
+		}
+		if c.Pattern != "" {
+			td.ImportRegexache = true
+			exprs = append(exprs, fmt.Sprintf(`validation.StringMatch(regexache.MustCompile(%s), "")`, quote(c.Pattern)))
+		}
+		if len(c.Enum) > 0 {
+			exprs = append(exprs, fmt.Sprintf("validation.StringInSlice([]string{%s}, false)", quoteAll(c.Enum)))
+		}
+	case "integer", "long":
+		switch {
+		case c.Min != nil && c.Max != nil:
+			exprs = append(exprs, fmt.Sprintf("validation.IntBetween(%d, %d)", *c.Min, *c.Max))
+		case c.Min != nil:
+			exprs = append(exprs, fmt.Sprintf("validation.IntAtLeast(%d)", *c.Min))
+		case c.Max != nil:
+			exprs = append(exprs, fmt.Sprintf("validation.IntAtMost(%d)", *c.Max))
+		}
+	}
+	// List sizes are validated with MinItems and MaxItems.
+
+	return exprs
+}
+
+func reportCoverage(g *common.Generator, dirs []string) error {
+	attrConsts, err := attributeConstants()
+	if err != nil {
+		return err
+	}
+
+	var arguments, validated, gaps int
+	for _, dir := range dirs {
+		servicePackage := filepath.Base(dir)
+		if abs, err := filepath.Abs(dir); err == nil {
+			servicePackage = filepath.Base(abs)
+		}
+
+		m, err := loadModel(servicePackage)
+		if err != nil {
+			g.Warnf("%s: skipping: %s", servicePackage, err)
+			continue
+		}
+
+		args, err := model.ScanArguments(dir, attrConsts)
+		if err != nil {
+			return fmt.Errorf("%s: %w", servicePackage, err)
+		}
+
+		c := m.Coverage(args)
+		arguments += c.Arguments
+		validated += c.Validated
+		gaps += len(c.Gaps)
+
+		if len(c.Gaps) == 0 {
+			continue
+		}
+
+		g.Infof("%s: %d arguments, %d validated, %d lacking validation:", servicePackage, c.Arguments, c.Validated, len(c.Gaps))
+		for _, gap := range c.Gaps {
+			g.Infof("  %s:%d %s: %s (%s)", gap.File, gap.Line, gap.Name, gap.Constraints, gap.Member)
+		}
+	}
+
+	g.Infof("Total: %d arguments, %d validated, %d lacking validation where the API model has constraints", arguments, validated, gaps)
+
+	return nil
+}
+
+func loadModel(servicePackage string) (*model.Model, error) {
+	records, err := data.ReadAllServiceData()
+	if err != nil {
+		return nil, fmt.Errorf("reading service data: %w", err)
+	}
+
+	for _, l := range records {
+		if l.ProviderPackage() != servicePackage {
+			continue
+		}
+
+		path, err := model.Find(*modelsDir, l.SDKID(), l.AWSCLIV2Command())
+		if err != nil {
+			return nil, err
+		}
+
+		return model.Load(path)
+	}
+
+	return nil, fmt.Errorf("service package %s not found", servicePackage)
+}
+
+func sdkModelsDir() (string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{ .Dir }}", sdkV1Module).Output()
+	if err != nil {
+		return "", fmt.Errorf("go list %s: %w", sdkV1Module, err)
+	}
+
+	return filepath.Join(strings.TrimSpace(string(out)), "models", "apis"), nil
+}
+
+// attributeConstants returns the names package's attribute constants.
+func attributeConstants() (map[string]string, error) {
+	out, err := exec.Command("go", "list", "-f", "{{ .Dir }}", "github.com/hashicorp/terraform-provider-aws/names").Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating names package: %w", err)
+	}
+
+	path := filepath.Join(strings.TrimSpace(string(out)), "attr_consts_gen.go")
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	consts := make(map[string]string)
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+			for i, name := range spec.Names {
+				if lit, ok := spec.Values[i].(*ast.BasicLit); ok {
+					if v, err := strconv.Unquote(lit.Value); err == nil {
+						consts[name.Name] = v
+					}
+				}
+			}
+		}
+	}
+
+	return consts, nil
+}
+
+func split(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// goName returns the idiomatic Go name for an API member, e.g. "CustomerEncryptionKeyARN" for "customerEncryptionKeyArn".
+func goName(member string) string {
+	var sb strings.Builder
+
+	for _, word := range strings.Split(model.SnakeCase(member), "_") {
+		switch word {
+		case "arn", "id", "kms", "url", "uri", "vpc", "iam", "ip", "dns", "ttl":
+			sb.WriteString(strings.ToUpper(word))
+		case "arns", "ids", "urls", "uris", "vpcs", "ips":
+			sb.WriteString(strings.ToUpper(strings.TrimSuffix(word, "s")) + "s")
+		default:
+			sb.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+
+	return sb.String()
+}
+
+func quote(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+//go:embed file.tmpl
+var tmpl string
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package model
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Argument is a string, integer or list schema attribute which is configurable.
+type Argument struct {
+	Name      string
+	File      string
+	Line      int
+	Validated bool
+}
+
+// Gap is an argument without validation whose API model has constraints.
+type Gap struct {
+	Argument
+	Member      string // e.g. "CreateAgentRequest.agentName".
+	Constraints Constraints
+}
+
+// Coverage summarizes the validation of a service package's arguments.
+type Coverage struct {
+	Arguments int
+	Validated int
+	Gaps      []Gap
+}
+
+// ScanArguments returns the arguments of the Plugin SDK and Plugin Framework
+// schemas defined in the Go source in dir. attrConsts maps the names package's
+// attribute constants to their values, e.g. "AttrName" to "name".
+func ScanArguments(dir string, attrConsts map[string]string) ([]Argument, error) {
+	fset := token.NewFileSet()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var args []Argument
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_gen.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", name, err)
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+
+			key := attributeName(kv.Key, attrConsts)
+			if key == "" {
+				return true
+			}
+
+			value := kv.Value
+			if u, ok := value.(*ast.UnaryExpr); ok && u.Op == token.AND {
+				value = u.X
+			}
+			lit, ok := value.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+
+			if arg, ok := argument(lit); ok {
+				arg.Name = key
+				arg.File = name
+				arg.Line = fset.Position(kv.Pos()).Line
+				args = append(args, arg)
+			}
+
+			return true
+		})
+	}
+
+	return args, nil
+}
+
+// Coverage matches unvalidated arguments to constrained members of the
+// structures describing the service's operation inputs.
+func (m *Model) Coverage(args []Argument) Coverage {
+	type candidate struct {
+		member      string
+		constraints Constraints
+	}
+	candidates := make(map[string]candidate)
+
+	for _, shape := range m.InputStructures() {
+		members, err := m.Members(shape)
+		if err != nil {
+			continue
+		}
+		for _, member := range members {
+			if member.Constraints.IsEmpty() {
+				continue
+			}
+			// Shapes are visited in sorted order, keep the first match.
+			if _, ok := candidates[member.TFName]; !ok {
+				candidates[member.TFName] = candidate{shape + "." + member.Name, member.Constraints}
+			}
+		}
+	}
+
+	var c Coverage
+	for _, arg := range args {
+		c.Arguments++
+		if arg.Validated {
+			c.Validated++
+			continue
+		}
+		if v, ok := candidates[arg.Name]; ok {
+			c.Gaps = append(c.Gaps, Gap{Argument: arg, Member: v.member, Constraints: v.constraints})
+		}
+	}
+
+	sort.SliceStable(c.Gaps, func(i, j int) bool {
+		if c.Gaps[i].File != c.Gaps[j].File {
+			return c.Gaps[i].File < c.Gaps[j].File
+		}
+		return c.Gaps[i].Line < c.Gaps[j].Line
+	})
+
+	return c
+}
+
+func attributeName(expr ast.Expr, attrConsts map[string]string) string {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return ""
+		}
+		s, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return ""
+		}
+		return s
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "names" {
+			return attrConsts[e.Sel.Name]
+		}
+	}
+
+	return ""
+}
+
+// argument returns whether the composite literal is a configurable Plugin SDK
+// schema.Schema or Plugin Framework schema attribute of a checked type.
+func argument(lit *ast.CompositeLit) (Argument, bool) {
+	var arg Argument
+	var sdkType, framework, configurable bool
+
+	switch t := lit.Type.(type) {
+	case nil:
+		// Elided type in a map[string]*schema.Schema literal.
+	case *ast.SelectorExpr:
+		switch t.Sel.Name {
+		case "Schema":
+		case "StringAttribute", "Int64Attribute", "Int32Attribute", "ListAttribute", "SetAttribute":
+			framework = true
+		default:
+			return arg, false
+		}
+	default:
+		return arg, false
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			return arg, false
+		}
+		field, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return arg, false
+		}
+
+		switch field.Name {
+		case "Type":
+			if sel, ok := kv.Value.(*ast.SelectorExpr); ok {
+				switch sel.Sel.Name {
+				case "TypeString", "TypeInt", "TypeList", "TypeSet":
+					sdkType = true
+				}
+			}
+		case "Required", "Optional":
+			if ident, ok := kv.Value.(*ast.Ident); ok && ident.Name == "true" {
+				configurable = true
+			}
+		case "ValidateFunc", "ValidateDiagFunc", "Validators", "CustomType", "MinItems", "MaxItems":
+			arg.Validated = true
+		}
+	}
+
+	return arg, configurable && (framework || sdkType)
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package model reads the constraints of AWS service API models.
+//
+// The models are the JSON representation of the services' Smithy models
+// distributed with the AWS SDK for Go in models/apis/<service>/<version>/api-2.json.
+//
+// The AWS SDK for Go v2 modules are not used: they don't include the Smithy
+// models, and the generated v2 code keeps enumerations but drops the length,
+// range and pattern traits. The v1 models are generated from the same Smithy
+// models, and the v1 module is already a dependency of the provider.
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const modelFilename = "api-2.json"
+
+// Model is a service API model.
+type Model struct {
+	Metadata struct {
+		ServiceID string `json:"serviceId"`
+	} `json:"metadata"`
+	Operations map[string]Operation `json:"operations"`
+	Shapes     map[string]*Shape    `json:"shapes"`
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Input *ShapeRef `json:"input"`
+}
+
+// ShapeRef is a reference to a named shape.
+type ShapeRef struct {
+	Shape            string `json:"shape"`
+	IdempotencyToken bool   `json:"idempotencyToken"`
+}
+
+// Shape is a named API type.
+type Shape struct {
+	Type     string              `json:"type"`
+	Required []string            `json:"required"`
+	Members  map[string]ShapeRef `json:"members"`
+	Member   *ShapeRef           `json:"member"`
+	Min      json.Number         `json:"min"`
+	Max      json.Number         `json:"max"`
+	Pattern  string              `json:"pattern"`
+	Enum     []string            `json:"enum"`
+}
+
+// Constraints are the plan-time checkable constraints of a shape.
+type Constraints struct {
+	Type    string // "string", "integer", "long" or "list".
+	Min     *int64
+	Max     *int64
+	Pattern string
+	Enum    []string
+
+	// UnsupportedPattern is a pattern which is not valid RE2 syntax, e.g. one using lookahead.
+	UnsupportedPattern string
+}
+
+// IsEmpty returns whether there are no constraints.
+func (c Constraints) IsEmpty() bool {
+	return c.Min == nil && c.Max == nil && c.Pattern == "" && len(c.Enum) == 0
+}
+
+// String returns a short description of the constraints, e.g. "length 1-100, pattern".
+func (c Constraints) String() string {
+	var parts []string
+
+	what := "length"
+	switch c.Type {
+	case "integer", "long":
+		what = "value"
+	case "list":
+		what = "size"
+	}
+
+	switch {
+	case c.Min != nil && c.Max != nil:
+		parts = append(parts, fmt.Sprintf("%s %d-%d", what, *c.Min, *c.Max))
+	case c.Min != nil:
+		parts = append(parts, fmt.Sprintf("%s >= %d", what, *c.Min))
+	case c.Max != nil:
+		parts = append(parts, fmt.Sprintf("%s <= %d", what, *c.Max))
+	}
+	if c.Pattern != "" {
+		parts = append(parts, "pattern")
+	}
+	if len(c.Enum) > 0 {
+		parts = append(parts, fmt.Sprintf("%d enum values", len(c.Enum)))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Member is a member of a structure shape.
+type Member struct {
+	Name        string // API member name, e.g. "agentName".
+	TFName      string // Conventional Terraform attribute name, e.g. "agent_name".
+	Shape       string
+	Required    bool
+	Constraints Constraints
+}
+
+// Find returns the path of the API model for the service with the specified
+// SDK ID (e.g. "Bedrock Agent") in the AWS SDK for Go models directory.
+// The model in the directory named hint (usually the AWS CLI command) is
+// checked first. If a service has several API versions, the latest is used.
+func Find(modelsDir, sdkID, hint string) (string, error) {
+	if hint != "" {
+		if path, err := latest(filepath.Join(modelsDir, hint)); err == nil {
+			if id, err := serviceID(path); err == nil && id == sdkID {
+				return path, nil
+			}
+		}
+	}
+
+	entries, err := os.ReadDir(modelsDir)
+	if err != nil {
+		return "", fmt.Errorf("reading API models directory (%s): %w", modelsDir, err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		path, err := latest(filepath.Join(modelsDir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		if id, err := serviceID(path); err == nil && id == sdkID {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("no API model found for %q in %s", sdkID, modelsDir)
+}
+
+// Load reads the API model at path.
+func Load(path string) (*Model, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading API model (%s): %w", path, err)
+	}
+
+	var m Model
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("parsing API model (%s): %w", path, err)
+	}
+
+	return &m, nil
+}
+
+// InputShape returns the name of the operation's input shape.
+func (m *Model) InputShape(operation string) (string, error) {
+	op, ok := m.Operations[operation]
+	if !ok {
+		return "", fmt.Errorf("operation %s not found", operation)
+	}
+	if op.Input == nil {
+		return "", fmt.Errorf("operation %s has no input", operation)
+	}
+
+	return op.Input.Shape, nil
+}
+
+// Members returns the members of the named structure shape, sorted by name.
+func (m *Model) Members(shape string) ([]Member, error) {
+	s, ok := m.Shapes[shape]
+	if !ok {
+		return nil, fmt.Errorf("shape %s not found", shape)
+	}
+	if s.Type != "structure" {
+		return nil, fmt.Errorf("shape %s is a %s, not a structure", shape, s.Type)
+	}
+
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+
+	members := make([]Member, 0, len(s.Members))
+	for name, ref := range s.Members {
+		// Idempotency tokens are generated by the provider, not configured.
+		if ref.IdempotencyToken {
+			continue
+		}
+		members = append(members, Member{
+			Name:        name,
+			TFName:      SnakeCase(name),
+			Shape:       ref.Shape,
+			Required:    required[name],
+			Constraints: m.Constraints(ref.Shape),
+		})
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Name < members[j].Name
+	})
+
+	return members, nil
+}
+
+// Constraints returns the constraints of the named shape.
+func (m *Model) Constraints(shape string) Constraints {
+	s, ok := m.Shapes[shape]
+	if !ok {
+		return Constraints{}
+	}
+
+	c := Constraints{Type: s.Type}
+
+	switch s.Type {
+	case "string":
+		c.Min, c.Max = number(s.Min), number(s.Max)
+		// A minimum length of zero is no constraint at all.
+		if c.Min != nil && *c.Min == 0 {
+			c.Min = nil
+		}
+		if s.Pattern != "" {
+			if _, err := regexp.Compile(s.Pattern); err == nil {
+				c.Pattern = s.Pattern
+			} else {
+				c.UnsupportedPattern = s.Pattern
+			}
+		}
+		c.Enum = s.Enum
+	case "integer", "long":
+		c.Min, c.Max = number(s.Min), number(s.Max)
+	case "list":
+		c.Min, c.Max = number(s.Min), number(s.Max)
+		if c.Min != nil && *c.Min == 0 {
+			c.Min = nil
+		}
+	}
+
+	return c
+}
+
+// InputStructures returns the names of all structure shapes reachable from
+// operation inputs, i.e. those describing arguments.
+func (m *Model) InputStructures() []string {
+	seen := make(map[string]bool)
+
+	var visit func(string)
+	visit = func(name string) {
+		s, ok := m.Shapes[name]
+		if !ok || seen[name] {
+			return
+		}
+		switch s.Type {
+		case "structure":
+			seen[name] = true
+			for _, ref := range s.Members {
+				visit(ref.Shape)
+			}
+		case "list":
+			if s.Member != nil {
+				visit(s.Member.Shape)
+			}
+		}
+	}
+
+	for _, op := range m.Operations {
+		if op.Input != nil {
+			visit(op.Input.Shape)
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// SnakeCase returns the conventional Terraform attribute name for an API
+// member name, e.g. "idle_session_ttl_in_seconds" for "idleSessionTTLInSeconds".
+func SnakeCase(name string) string {
+	var sb strings.Builder
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if isUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && isLower(runes[i+1])
+			if isLower(prev) || isDigit(prev) || isUpper(prev) && nextIsLower {
+				sb.WriteRune('_')
+			}
+		}
+		sb.WriteString(strings.ToLower(string(r)))
+	}
+
+	return sb.String()
+}
+
+func isUpper(r rune) bool { return r >= 'A' && r <= 'Z' }
+func isLower(r rune) bool { return r >= 'a' && r <= 'z' }
+func isDigit(r rune) bool { return r >= '0' && r <= '9' }
+
+func number(n json.Number) *int64 {
+	if n == "" {
+		return nil
+	}
+
+	v, err := n.Int64()
+	if err != nil {
+		// Some bounds are written as floating point numbers.
+		f, err := n.Float64()
+		if err != nil {
+			return nil
+		}
+		v = int64(f)
+	}
+
+	return &v
+}
+
+// latest returns the path of the API model with the latest version in dir.
+func latest(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			versions = append(versions, entry.Name())
+		}
+	}
+	if len(versions) == 0 {
+		return "", errors.New("no API versions in " + dir)
+	}
+
+	// API versions are dates, e.g. "2023-06-05".
+	sort.Strings(versions)
+	path := filepath.Join(dir, versions[len(versions)-1], modelFilename)
+	if _, err := os.Stat(path); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+func serviceID(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	var m struct {
+		Metadata struct {
+			ServiceID string `json:"serviceId"`
+		} `json:"metadata"`
+	}
+	if err := json.NewDecoder(f).Decode(&m); err != nil {
+		return "", err
+	}
+
+	return m.Metadata.ServiceID, nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package model
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+var testModelsDir = filepath.Join("testdata", "models")
+
+func loadTestModel(t *testing.T) *Model {
+	t.Helper()
+
+	path, err := Find(testModelsDir, "Widgets", "")
+	if err != nil {
+		t.Fatalf("finding model: %s", err)
+	}
+
+	m, err := Load(path)
+	if err != nil {
+		t.Fatalf("loading model: %s", err)
+	}
+
+	return m
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		sdkID   string
+		hint    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "hint",
+			sdkID: "Widgets",
+			hint:  "widgets",
+			want:  filepath.Join(testModelsDir, "widgets", "2020-01-01", "api-2.json"),
+		},
+		{
+			name:  "wrong hint",
+			sdkID: "Other Widgets",
+			hint:  "widgets",
+			want:  filepath.Join(testModelsDir, "other", "2020-01-01", "api-2.json"),
+		},
+		{
+			name:    "not found",
+			sdkID:   "Gadgets",
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Find(testModelsDir, testCase.sdkID, testCase.hint)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+			if got != testCase.want {
+				t.Errorf("Find = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestMembers(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+
+	shape, err := m.InputShape("CreateWidget")
+	if err != nil {
+		t.Fatalf("InputShape: %s", err)
+	}
+	if _, err := m.InputShape("ListWidgets"); err == nil {
+		t.Errorf("InputShape(ListWidgets) succeeded, want error")
+	}
+
+	members, err := m.Members(shape)
+	if err != nil {
+		t.Fatalf("Members: %s", err)
+	}
+
+	byName := make(map[string]Member)
+	var names []string
+	for _, member := range members {
+		byName[member.Name] = member
+		names = append(names, member.Name)
+	}
+
+	// Idempotency tokens are not arguments.
+	if got, want := names, []string{"description", "kmsKeyArn", "settings", "sizeInGB", "widgetName", "widgetType"}; !slices.Equal(got, want) {
+		t.Errorf("members = %v, want %v", got, want)
+	}
+
+	for name, want := range map[string]string{
+		"description": "length <= 200",
+		"kmsKeyArn":   "length 1-2048, pattern",
+		"settings":    "",
+		"sizeInGB":    "value 1-1000",
+		"widgetName":  "length 1-100, pattern",
+		"widgetType":  "2 enum values",
+	} {
+		if got := byName[name].Constraints.String(); got != want {
+			t.Errorf("%s constraints = %q, want %q", name, got, want)
+		}
+	}
+
+	if got, want := byName["sizeInGB"].TFName, "size_in_gb"; got != want {
+		t.Errorf("sizeInGB TFName = %q, want %q", got, want)
+	}
+	if !byName["widgetName"].Required || byName["description"].Required {
+		t.Errorf("required members incorrect")
+	}
+
+	settings, err := m.Members("WidgetSettings")
+	if err != nil {
+		t.Fatalf("Members: %s", err)
+	}
+	if c := settings[0].Constraints; c.Pattern != "" || c.UnsupportedPattern != "^(?!aws:).*$" {
+		t.Errorf("lookahead pattern = %q, unsupported %q", c.Pattern, c.UnsupportedPattern)
+	}
+	if got, want := settings[1].Constraints.String(), "size <= 50"; got != want {
+		t.Errorf("tags constraints = %q, want %q", got, want)
+	}
+
+	if _, err := m.Members("Name"); err == nil {
+		t.Errorf("Members(Name) succeeded, want error")
+	}
+}
+
+func TestInputStructures(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+
+	if got, want := m.InputStructures(), []string{"CreateWidgetRequest", "WidgetSettings"}; !slices.Equal(got, want) {
+		t.Errorf("InputStructures = %v, want %v", got, want)
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	for name, want := range map[string]string{
+		"agentName":               "agent_name",
+		"AgentName":               "agent_name",
+		"idleSessionTTLInSeconds": "idle_session_ttl_in_seconds",
+		"kmsKeyArn":               "kms_key_arn",
+		"sizeInGB":                "size_in_gb",
+		"PrincipalOrgID":          "principal_org_id",
+		"s3Key":                   "s3_key",
+	} {
+		if got := SnakeCase(name); got != want {
+			t.Errorf("SnakeCase(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	t.Parallel()
+
+	m := loadTestModel(t)
+
+	args, err := ScanArguments(filepath.Join("testdata", "service"), map[string]string{"AttrDescription": "description"})
+	if err != nil {
+		t.Fatalf("ScanArguments: %s", err)
+	}
+
+	var names []string
+	for _, arg := range args {
+		names = append(names, arg.Name)
+	}
+	if got, want := names, []string{"description", "widget_name", "settings", "tags", "kms_key_arn", "size_in_gb", "widget_type"}; !slices.Equal(got, want) {
+		t.Errorf("arguments = %v, want %v", got, want)
+	}
+
+	c := m.Coverage(args)
+
+	if got, want := c.Arguments, 7; got != want {
+		t.Errorf("Arguments = %d, want %d", got, want)
+	}
+	if got, want := c.Validated, 3; got != want {
+		t.Errorf("Validated = %d, want %d", got, want)
+	}
+
+	var gaps []string
+	for _, gap := range c.Gaps {
+		gaps = append(gaps, gap.Name+" "+gap.Member)
+	}
+	if got, want := gaps, []string{
+		"description CreateWidgetRequest.description",
+		"tags WidgetSettings.tags",
+		"kms_key_arn CreateWidgetRequest.kmsKeyArn",
+	}; !slices.Equal(got, want) {
+		t.Errorf("gaps = %v, want %v", got, want)
+	}
+}
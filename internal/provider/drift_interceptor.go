@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type driftContextKey int
+
+var driftPriorStateKey driftContextKey
+
+// driftResourceInterceptor reports arguments changed outside of Terraform, detected during refresh.
+type driftResourceInterceptor struct {
+	typeName string
+	schema   map[string]*schema.Schema
+}
+
+func (r driftResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if why != Read {
+		return ctx, diags
+	}
+
+	if c, ok := meta.(*conns.AWSClient); !ok || !c.ReportDrift(ctx) {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Record the prior state.
+		if state := d.State(); state != nil {
+			ctx = context.WithValue(ctx, driftPriorStateKey, maps.Clone(state.Attributes))
+		}
+	case After:
+		// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+		if d.Id() == "" {
+			return ctx, diags
+		}
+
+		before, ok := ctx.Value(driftPriorStateKey).(map[string]string)
+		if !ok {
+			return ctx, diags
+		}
+
+		state := d.State()
+		if state == nil {
+			return ctx, diags
+		}
+
+		if drifted := driftedAttributes(r.schema, before, state.Attributes); len(drifted) > 0 {
+			diags = append(diags, newDriftWarningDiagnostic(r.typeName, d.Id(), drifted))
+		}
+	}
+
+	return ctx, diags
+}
+
+// driftedAttributes returns the names of the configurable top-level attributes
+// whose flatmapped values differ between the prior and refreshed states.
+// Attributes not in the prior state, e.g. after import or a schema change, are ignored.
+func driftedAttributes(s map[string]*schema.Schema, before, after map[string]string) []string {
+	// Only the ID is known after import.
+	if len(before) <= 1 {
+		return nil
+	}
+
+	drifted := make(map[string]struct{})
+
+	check := func(k string) {
+		name, _, _ := strings.Cut(k, ".")
+		if _, ok := drifted[name]; ok {
+			return
+		}
+		if !configurable(s, name) || !inFlatmap(before, name) {
+			return
+		}
+		if before[k] != after[k] {
+			drifted[name] = struct{}{}
+		}
+	}
+
+	for k := range before {
+		check(k)
+	}
+	for k := range after {
+		check(k)
+	}
+
+	attributes := make([]string, 0, len(drifted))
+	for name := range drifted {
+		attributes = append(attributes, name)
+	}
+	sort.Strings(attributes)
+
+	return attributes
+}
+
+func configurable(s map[string]*schema.Schema, name string) bool {
+	switch name {
+	case names.AttrID, names.AttrTagsAll, "timeouts":
+		return false
+	}
+
+	v, ok := s[name]
+
+	return ok && (v.Optional || v.Required)
+}
+
+// inFlatmap returns whether the top-level attribute is present in the flatmapped state.
+func inFlatmap(m map[string]string, name string) bool {
+	for _, k := range []string{name, name + ".#", name + ".%"} {
+		if _, ok := m[k]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newDriftWarningDiagnostic(typeName, id string, attributes []string) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s (%s) changed outside of Terraform", typeName, id),
+		Detail: fmt.Sprintf("The following arguments changed since the last Terraform operation: %s.\n\n"+
+			"Run \"terraform plan\" to review the changes. Update the configuration to keep them, or apply it to revert them.", strings.Join(attributes, ", ")),
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDriftedAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"arn": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"new_argument": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"rule": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"tags_all": {
+			Type:     schema.TypeMap,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+
+	prior := map[string]string{
+		"id":           "test",
+		"arn":          "arn:aws:example:::test",
+		"description":  "",
+		"name":         "test",
+		"rule.#":       "1",
+		"rule.0.value": "a",
+		"tags.%":       "1",
+		"tags.Env":     "test",
+		"tags_all.%":   "1",
+		"tags_all.Env": "test",
+	}
+
+	testCases := map[string]struct {
+		before   map[string]string
+		after    map[string]string
+		expected []string
+	}{
+		"no changes": {
+			before: prior,
+			after:  prior,
+		},
+		"import": {
+			before: map[string]string{"id": "test"},
+			after:  prior,
+		},
+		"computed changed": {
+			before: prior,
+			after:  with(prior, map[string]string{"arn": "arn:aws:example:::other"}),
+		},
+		"new argument": {
+			before: prior,
+			after:  with(prior, map[string]string{"new_argument": "default"}),
+		},
+		"arguments changed": {
+			before: prior,
+			after: with(prior, map[string]string{
+				"description":   "changed in console",
+				"rule.#":        "2",
+				"rule.1.value":  "b",
+				"tags.Owner":    "ops",
+				"tags.%":        "2",
+				"tags_all.%":    "2",
+				"tags_all.Team": "ops",
+			}),
+			expected: []string{"description", "rule", "tags"},
+		},
+		"nested argument removed": {
+			before:   prior,
+			after:    with(prior, map[string]string{"rule.#": "0", "rule.0.value": ""}),
+			expected: []string{"rule"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := driftedAttributes(s, testCase.before, testCase.after)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func with(m, overrides map[string]string) map[string]string {
+	v := make(map[string]string, len(m)+len(overrides))
+	for k, s := range m {
+		v[k] = s
+	}
+	for k, s := range overrides {
+		v[k] = s
+	}
+	return v
+}
@@ -6,12 +6,15 @@ package fwprovider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -653,3 +656,147 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// decodeAuthorizationMessageDataSourceInterceptor decodes encoded authorization failure messages
+// in data source error diagnostics if configured to do so.
+type decodeAuthorizationMessageDataSourceInterceptor struct{}
+
+func (r decodeAuthorizationMessageDataSourceInterceptor) read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+// decodeAuthorizationMessageResourceInterceptor decodes encoded authorization failure messages
+// in resource error diagnostics if configured to do so.
+type decodeAuthorizationMessageResourceInterceptor struct{}
+
+func (r decodeAuthorizationMessageResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func (r decodeAuthorizationMessageResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func (r decodeAuthorizationMessageResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func (r decodeAuthorizationMessageResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, decodeAuthorizationMessages(ctx, meta, when, diags)
+}
+
+func decodeAuthorizationMessages(ctx context.Context, meta *conns.AWSClient, when when, diags diag.Diagnostics) diag.Diagnostics {
+	if when != OnError || meta == nil || !meta.DecodeAuthorizationMessages(ctx) {
+		return diags
+	}
+
+	for i, v := range diags {
+		if v.Severity() != diag.SeverityError {
+			continue
+		}
+
+		encoded := errs.EncodedAuthorizationMessage(v.Summary() + "\n" + v.Detail())
+		if encoded == "" {
+			continue
+		}
+
+		decoded, err := meta.DecodeAuthorizationMessage(ctx, encoded)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to decode authorization failure message", map[string]any{
+				"error": err.Error(),
+			})
+			continue
+		}
+
+		detail := errs.AppendDetail(v.Detail(), "Decoded authorization failure message:\n"+decoded)
+		if v, ok := v.(diag.DiagnosticWithPath); ok {
+			diags[i] = diag.NewAttributeErrorDiagnostic(v.Path(), v.Summary(), detail)
+		} else {
+			diags[i] = diag.NewErrorDiagnostic(v.Summary(), detail)
+		}
+	}
+
+	return diags
+}
+
+// driftResourceInterceptor reports arguments changed outside of Terraform, detected during refresh.
+type driftResourceInterceptor struct {
+	typeName string
+}
+
+func (r driftResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r driftResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != After || meta == nil || !meta.ReportDrift(ctx) {
+		return ctx, diags
+	}
+
+	// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated.
+	if request.State.Raw.IsNull() || response.State.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	valueDiffs, err := request.State.Raw.Diff(response.State.Raw)
+	if err != nil {
+		tflog.Warn(ctx, "Unable to compare prior and refreshed states", map[string]any{
+			"error": err.Error(),
+		})
+		return ctx, diags
+	}
+
+	drifted := make(map[string]struct{})
+	for _, v := range valueDiffs {
+		if v.Path == nil || len(v.Path.Steps()) == 0 {
+			continue
+		}
+		name, ok := v.Path.Steps()[0].(tftypes.AttributeName)
+		if !ok {
+			continue
+		}
+		// Attributes not in the prior state, e.g. after import or a schema change, are ignored.
+		if v.Value1 == nil || v.Value1.IsNull() {
+			continue
+		}
+		switch name := string(name); name {
+		case names.AttrID, names.AttrTagsAll, "timeouts":
+		default:
+			// Only report configurable attributes and blocks.
+			if v, ok := request.State.Schema.GetAttributes()[name]; ok && (v.IsOptional() || v.IsRequired()) {
+				drifted[name] = struct{}{}
+			} else if _, ok := request.State.Schema.GetBlocks()[name]; ok {
+				drifted[name] = struct{}{}
+			}
+		}
+	}
+
+	if len(drifted) == 0 {
+		return ctx, diags
+	}
+
+	attributes := make([]string, 0, len(drifted))
+	for name := range drifted {
+		attributes = append(attributes, name)
+	}
+	sort.Strings(attributes)
+
+	var id fwtypes.String
+	response.State.GetAttribute(ctx, path.Root(names.AttrID), &id)
+
+	diags.AddWarning(
+		fmt.Sprintf("%s (%s) changed outside of Terraform", r.typeName, id.ValueString()),
+		fmt.Sprintf("The following arguments changed since the last Terraform operation: %s.\n\n"+
+			"Run \"terraform plan\" to review the changes. Update the configuration to keep them, or apply it to revert them.", strings.Join(attributes, ", ")),
+	)
+
+	return ctx, diags
+}
+
+func (r driftResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r driftResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
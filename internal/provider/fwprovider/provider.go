@@ -60,6 +60,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"decode_authorization_messages": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Decode encoded authorization failure messages in errors using the STS DecodeAuthorizationMessage API. Requires the `sts:DecodeAuthorizationMessage` permission.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -100,6 +104,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS operations will take place. Examples\nare us-east-1, us-west-2, etc.", // lintignore:AWSAT003
 			},
+			"report_drift": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Report arguments of managed resources changed outside of Terraform, detected during refresh, as warnings.",
+			},
 			"retry_mode": schema.StringAttribute{
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
@@ -341,7 +349,9 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 
 				return ctx
 			}
-			interceptors := dataSourceInterceptors{}
+			interceptors := dataSourceInterceptors{
+				decodeAuthorizationMessageDataSourceInterceptor{},
+			}
 
 			if v.Tags != nil {
 				// The data source has opted in to transparent tagging.
@@ -412,7 +422,10 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				decodeAuthorizationMessageResourceInterceptor{},
+				driftResourceInterceptor{typeName: typeName},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -32,6 +33,7 @@ type schemaResourceData interface {
 	HasChange(key string) bool
 	Id() string
 	Set(string, any) error
+	State() *terraform.InstanceState
 }
 
 // An interceptor is functionality invoked during the CRUD request lifecycle.
@@ -454,3 +456,41 @@ func (r tagsDataSourceInterceptor) run(ctx context.Context, d schemaResourceData
 
 	return ctx, diags
 }
+
+// decodeAuthorizationMessageInterceptor decodes encoded authorization failure messages
+// in error diagnostics if configured to do so.
+type decodeAuthorizationMessageInterceptor struct{}
+
+func (r decodeAuthorizationMessageInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != OnError {
+		return ctx, diags
+	}
+
+	c, ok := meta.(*conns.AWSClient)
+	if !ok || !c.DecodeAuthorizationMessages(ctx) {
+		return ctx, diags
+	}
+
+	for i, v := range diags {
+		if v.Severity != diag.Error {
+			continue
+		}
+
+		encoded := errs.EncodedAuthorizationMessage(v.Summary + "\n" + v.Detail)
+		if encoded == "" {
+			continue
+		}
+
+		decoded, err := c.DecodeAuthorizationMessage(ctx, encoded)
+		if err != nil {
+			tflog.Warn(ctx, "Unable to decode authorization failure message", map[string]any{
+				"error": err.Error(),
+			})
+			continue
+		}
+
+		diags[i].Detail = errs.AppendDetail(v.Detail, "Decoded authorization failure message:\n"+decoded)
+	}
+
+	return ctx, diags
+}
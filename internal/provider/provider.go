@@ -58,6 +58,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Can also be configured using the `AWS_CA_BUNDLE` environment variable. " +
 					"(Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"decode_authorization_messages": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Decode encoded authorization failure messages in errors using the STS DecodeAuthorizationMessage API. " +
+					"Requires the `sts:DecodeAuthorizationMessage` permission.",
+			},
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -158,6 +164,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"report_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Report arguments of managed resources changed outside of Terraform, " +
+					"detected during refresh, as warnings.",
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -296,7 +308,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         Read,
+					interceptor: decodeAuthorizationMessageInterceptor{},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -373,7 +391,21 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        OnError,
+					why:         AllOps,
+					interceptor: decodeAuthorizationMessageInterceptor{},
+				},
+				{
+					when: Before | After,
+					why:  Read,
+					interceptor: driftResourceInterceptor{
+						typeName: typeName,
+						schema:   r.SchemaMap(),
+					},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -478,6 +510,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		DecodeAuthorizationMessages:    d.Get("decode_authorization_messages").(bool),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
@@ -485,6 +518,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
+		ReportDrift:                    d.Get("report_drift").(bool),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -8,6 +8,7 @@ var (
 	ArchiveRuleParseResourceID  = archiveRuleParseResourceID
 	FindAnalyzerByName          = findAnalyzerByName
 	FindArchiveRuleByTwoPartKey = findArchiveRuleByTwoPartKey
+	PolicyFindingMeetsThreshold = policyFindingMeetsThreshold
 
 	ResourceAnalyzer    = resourceAnalyzer
 	ResourceArchiveRule = resourceArchiveRule
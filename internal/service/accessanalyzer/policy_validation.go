@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	policyValidationCheckNoNewAccess  = "check_no_new_access"
+	policyValidationSeverityThreshold = "severity_threshold"
+)
+
+// PolicyValidationSchema returns the schema for the opt-in `policy_validation` block
+// added to resources whose policy documents are validated at plan time by PolicyValidationCustomizeDiff.
+func PolicyValidationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				policyValidationCheckNoNewAccess: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				policyValidationSeverityThreshold: {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          string(types.ValidatePolicyFindingTypeError),
+					ValidateDiagFunc: enum.Validate[types.ValidatePolicyFindingType](),
+				},
+			},
+		},
+	}
+}
+
+// PolicyValidationCustomizeDiff returns a CustomizeDiffFunc that validates the planned value of
+// the `policy` attribute with IAM Access Analyzer when the resource's `policy_validation` block is configured.
+// Findings at or above the configured severity threshold fail the plan.
+// A CustomizeDiffFunc can't return warning diagnostics, so findings below the threshold are only logged.
+func PolicyValidationCustomizeDiff(policyType types.PolicyType, resourceType types.ValidatePolicyResourceType) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		tfList, ok := d.Get("policy_validation").([]interface{})
+		if !ok || len(tfList) == 0 {
+			return nil
+		}
+
+		// An empty `policy_validation {}` block enables validation with the default settings.
+		tfMap := map[string]interface{}{
+			policyValidationCheckNoNewAccess:  false,
+			policyValidationSeverityThreshold: string(types.ValidatePolicyFindingTypeError),
+		}
+		if v, ok := tfList[0].(map[string]interface{}); ok {
+			tfMap = v
+		}
+
+		if !d.HasChanges(names.AttrPolicy, "policy_validation") {
+			return nil
+		}
+
+		if !d.NewValueKnown(names.AttrPolicy) {
+			log.Printf("[DEBUG] Skipping IAM Access Analyzer policy validation: policy is not known until apply")
+			return nil
+		}
+
+		policy, err := structure.NormalizeJsonString(d.Get(names.AttrPolicy).(string))
+		if err != nil {
+			return fmt.Errorf("policy (%s) is invalid JSON: %w", d.Get(names.AttrPolicy).(string), err)
+		}
+
+		conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+		input := &accessanalyzer.ValidatePolicyInput{
+			PolicyDocument: aws.String(policy),
+			PolicyType:     policyType,
+		}
+		if resourceType != "" {
+			input.ValidatePolicyResourceType = resourceType
+		}
+
+		findings, err := findValidatePolicyFindings(ctx, conn, input)
+
+		if err != nil {
+			return fmt.Errorf("validating policy with IAM Access Analyzer: %w", err)
+		}
+
+		threshold := types.ValidatePolicyFindingType(tfMap[policyValidationSeverityThreshold].(string))
+		var errs []error
+
+		for _, finding := range findings {
+			if !policyFindingMeetsThreshold(finding, threshold) {
+				log.Printf("[WARN] IAM Access Analyzer policy validation: %s", policyFindingString(finding))
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("IAM Access Analyzer policy validation: %s", policyFindingString(finding)))
+		}
+
+		if tfMap[policyValidationCheckNoNewAccess].(bool) && d.Id() != "" {
+			o, _ := d.GetChange(names.AttrPolicy)
+
+			if existing := o.(string); existing != "" {
+				if err := checkPolicyNoNewAccess(ctx, conn, policyType, existing, policy); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+func checkPolicyNoNewAccess(ctx context.Context, conn *accessanalyzer.Client, policyType types.PolicyType, existing, policy string) error {
+	accessCheckPolicyType, ok := accessCheckPolicyTypeFor(policyType)
+	if !ok {
+		log.Printf("[DEBUG] Skipping IAM Access Analyzer no new access check: unsupported policy type (%s)", policyType)
+		return nil
+	}
+
+	existingPolicy, err := structure.NormalizeJsonString(existing)
+	if err != nil {
+		return fmt.Errorf("existing policy (%s) is invalid JSON: %w", existing, err)
+	}
+
+	input := &accessanalyzer.CheckNoNewAccessInput{
+		ExistingPolicyDocument: aws.String(existingPolicy),
+		NewPolicyDocument:      aws.String(policy),
+		PolicyType:             accessCheckPolicyType,
+	}
+
+	output, err := conn.CheckNoNewAccess(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("checking policy for new access with IAM Access Analyzer: %w", err)
+	}
+
+	if output.Result == types.CheckNoNewAccessResultFail {
+		return fmt.Errorf("IAM Access Analyzer no new access check: %s", checkNoNewAccessOutputString(output))
+	}
+
+	return nil
+}
+
+// accessCheckPolicyTypeFor returns the CheckNoNewAccess policy type corresponding to a ValidatePolicy policy type.
+func accessCheckPolicyTypeFor(policyType types.PolicyType) (types.AccessCheckPolicyType, bool) {
+	switch policyType {
+	case types.PolicyTypeIdentityPolicy:
+		return types.AccessCheckPolicyTypeIdentityPolicy, true
+	case types.PolicyTypeResourcePolicy:
+		return types.AccessCheckPolicyTypeResourcePolicy, true
+	default:
+		return "", false
+	}
+}
+
+func findValidatePolicyFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]types.ValidatePolicyFinding, error) {
+	var output []types.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+// policyFindingSeverity returns the relative severity of a policy validation finding type.
+// Unknown finding types are treated as the most severe.
+func policyFindingSeverity(findingType types.ValidatePolicyFindingType) int {
+	switch findingType {
+	case types.ValidatePolicyFindingTypeSuggestion:
+		return 0
+	case types.ValidatePolicyFindingTypeWarning:
+		return 1
+	case types.ValidatePolicyFindingTypeSecurityWarning:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func policyFindingMeetsThreshold(finding types.ValidatePolicyFinding, threshold types.ValidatePolicyFindingType) bool {
+	return policyFindingSeverity(finding.FindingType) >= policyFindingSeverity(threshold)
+}
+
+func policyFindingString(finding types.ValidatePolicyFinding) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s finding %s: %s", finding.FindingType, aws.ToString(finding.IssueCode), aws.ToString(finding.FindingDetails))
+	if v := aws.ToString(finding.LearnMoreLink); v != "" {
+		fmt.Fprintf(&sb, " (%s)", v)
+	}
+
+	return sb.String()
+}
+
+func checkNoNewAccessOutputString(output *accessanalyzer.CheckNoNewAccessOutput) string {
+	var sb strings.Builder
+
+	sb.WriteString(aws.ToString(output.Message))
+	for _, reason := range output.Reasons {
+		sb.WriteString("; ")
+		if v := aws.ToString(reason.StatementId); v != "" {
+			fmt.Fprintf(&sb, "statement %q: ", v)
+		} else if reason.StatementIndex != nil {
+			fmt.Fprintf(&sb, "statement %d: ", aws.ToInt32(reason.StatementIndex))
+		}
+		sb.WriteString(aws.ToString(reason.Description))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_policy_validation", name="Policy Validation")
+func dataSourcePolicyValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourcePolicyValidationRead,
+
+		Schema: map[string]*schema.Schema{
+			"existing_policy_document": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"finding_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issue_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"learn_more_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"locale": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.Locale](),
+			},
+			"no_new_access": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reasons": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"statement_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"statement_index": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.PolicyType](),
+			},
+			policyValidationSeverityThreshold: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ValidatePolicyFindingType](),
+			},
+			"validate_policy_resource_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ValidatePolicyResourceType](),
+			},
+		},
+	}
+}
+
+func dataSourcePolicyValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policy, err := structure.NormalizeJsonString(d.Get("policy_document").(string))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "policy_document (%s) is invalid JSON: %s", d.Get("policy_document").(string), err)
+	}
+
+	policyType := types.PolicyType(d.Get("policy_type").(string))
+	input := &accessanalyzer.ValidatePolicyInput{
+		PolicyDocument: aws.String(policy),
+		PolicyType:     policyType,
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = types.Locale(v.(string))
+	}
+
+	if v, ok := d.GetOk("validate_policy_resource_type"); ok {
+		input.ValidatePolicyResourceType = types.ValidatePolicyResourceType(v.(string))
+	}
+
+	findings, err := findValidatePolicyFindings(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "validating IAM Access Analyzer policy: %s", err)
+	}
+
+	var noNewAccess []interface{}
+
+	if v, ok := d.GetOk("existing_policy_document"); ok {
+		accessCheckPolicyType, ok := accessCheckPolicyTypeFor(policyType)
+		if !ok {
+			return sdkdiag.AppendErrorf(diags, "existing_policy_document is not supported for policy_type %s", policyType)
+		}
+
+		existingPolicy, err := structure.NormalizeJsonString(v.(string))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "existing_policy_document (%s) is invalid JSON: %s", v.(string), err)
+		}
+
+		input := &accessanalyzer.CheckNoNewAccessInput{
+			ExistingPolicyDocument: aws.String(existingPolicy),
+			NewPolicyDocument:      aws.String(policy),
+			PolicyType:             accessCheckPolicyType,
+		}
+
+		output, err := conn.CheckNoNewAccess(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer policy for new access: %s", err)
+		}
+
+		noNewAccess = []interface{}{flattenCheckNoNewAccessOutput(output)}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("findings", flattenValidatePolicyFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+	if err := d.Set("no_new_access", noNewAccess); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting no_new_access: %s", err)
+	}
+
+	if v, ok := d.GetOk(policyValidationSeverityThreshold); ok {
+		threshold := types.ValidatePolicyFindingType(v.(string))
+
+		for _, finding := range findings {
+			if policyFindingMeetsThreshold(finding, threshold) {
+				diags = sdkdiag.AppendErrorf(diags, "IAM Access Analyzer policy validation: %s", policyFindingString(finding))
+			} else {
+				diags = sdkdiag.AppendWarningf(diags, "IAM Access Analyzer policy validation: %s", policyFindingString(finding))
+			}
+		}
+	}
+
+	return diags
+}
+
+func flattenValidatePolicyFindings(apiObjects []types.ValidatePolicyFinding) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"finding_details": aws.ToString(apiObject.FindingDetails),
+			"finding_type":    string(apiObject.FindingType),
+			"issue_code":      aws.ToString(apiObject.IssueCode),
+			"learn_more_link": aws.ToString(apiObject.LearnMoreLink),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCheckNoNewAccessOutput(apiObject *accessanalyzer.CheckNoNewAccessOutput) map[string]interface{} {
+	tfList := make([]interface{}, 0, len(apiObject.Reasons))
+
+	for _, reason := range apiObject.Reasons {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrDescription: aws.ToString(reason.Description),
+			"statement_id":        aws.ToString(reason.StatementId),
+			"statement_index":     int(aws.ToInt32(reason.StatementIndex)),
+		})
+	}
+
+	return map[string]interface{}{
+		names.AttrMessage: aws.ToString(apiObject.Message),
+		"reasons":         tfList,
+		"result":          string(apiObject.Result),
+	}
+}
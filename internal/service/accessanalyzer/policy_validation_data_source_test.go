@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestPolicyFindingMeetsThreshold(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		findingType types.ValidatePolicyFindingType
+		threshold   types.ValidatePolicyFindingType
+		expected    bool
+	}{
+		{types.ValidatePolicyFindingTypeError, types.ValidatePolicyFindingTypeError, true},
+		{types.ValidatePolicyFindingTypeSecurityWarning, types.ValidatePolicyFindingTypeError, false},
+		{types.ValidatePolicyFindingTypeSecurityWarning, types.ValidatePolicyFindingTypeSecurityWarning, true},
+		{types.ValidatePolicyFindingTypeError, types.ValidatePolicyFindingTypeSecurityWarning, true},
+		{types.ValidatePolicyFindingTypeWarning, types.ValidatePolicyFindingTypeSecurityWarning, false},
+		{types.ValidatePolicyFindingTypeWarning, types.ValidatePolicyFindingTypeWarning, true},
+		{types.ValidatePolicyFindingTypeSuggestion, types.ValidatePolicyFindingTypeWarning, false},
+		{types.ValidatePolicyFindingTypeSuggestion, types.ValidatePolicyFindingTypeSuggestion, true},
+		{types.ValidatePolicyFindingTypeError, types.ValidatePolicyFindingTypeSuggestion, true},
+	}
+
+	for _, testCase := range testCases {
+		finding := types.ValidatePolicyFinding{
+			FindingType: testCase.findingType,
+			IssueCode:   aws.String("TEST"),
+		}
+
+		if got, want := tfaccessanalyzer.PolicyFindingMeetsThreshold(finding, testCase.threshold), testCase.expected; got != want {
+			t.Errorf("PolicyFindingMeetsThreshold(%s, %s) = %t, want %t", testCase.findingType, testCase.threshold, got, want)
+		}
+	}
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "findings.*", map[string]string{
+						"finding_type": string(types.ValidatePolicyFindingTypeSecurityWarning),
+						"issue_code":   "PASS_ROLE_WITH_STAR_IN_RESOURCE",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "no_new_access.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_existingPolicyDocument(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_existingPolicyDocument(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "no_new_access.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "no_new_access.0.result", string(types.CheckNoNewAccessResultFail)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "no_new_access.0.message"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_severityThreshold(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyValidationDataSourceConfig_severityThreshold(string(types.ValidatePolicyFindingTypeSecurityWarning)),
+				ExpectError: regexache.MustCompile(`SECURITY_WARNING finding PASS_ROLE_WITH_STAR_IN_RESOURCE`),
+			},
+			{
+				Config: testAccPolicyValidationDataSourceConfig_severityThreshold(string(types.ValidatePolicyFindingTypeError)),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig_passRolePolicy = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["iam:PassRole"]
+    resources = ["*"]
+  }
+}
+`
+
+func testAccPolicyValidationDataSourceConfig_basic() string {
+	return acctest.ConfigCompose(testAccPolicyValidationDataSourceConfig_passRolePolicy, `
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`)
+}
+
+func testAccPolicyValidationDataSourceConfig_existingPolicyDocument() string {
+	return acctest.ConfigCompose(testAccPolicyValidationDataSourceConfig_passRolePolicy, `
+data "aws_iam_policy_document" "existing" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:${data.aws_partition.current.partition}:s3:::example/*"]
+  }
+}
+
+data "aws_partition" "current" {}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  existing_policy_document = data.aws_iam_policy_document.existing.json
+  policy_document          = data.aws_iam_policy_document.test.json
+  policy_type              = "IDENTITY_POLICY"
+}
+`)
+}
+
+func testAccPolicyValidationDataSourceConfig_severityThreshold(threshold string) string {
+	return acctest.ConfigCompose(testAccPolicyValidationDataSourceConfig_passRolePolicy, fmt.Sprintf(`
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document    = data.aws_iam_policy_document.test.json
+  policy_type        = "IDENTITY_POLICY"
+  severity_threshold = %[1]q
+}
+`, threshold))
+}
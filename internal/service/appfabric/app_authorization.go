@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appfabric"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="App Authorization")
+// @Tags(identifierAttribute="arn")
+func newAppAuthorizationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &appAuthorizationResource{}
+
+	return r, nil
+}
+
+type appAuthorizationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*appAuthorizationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appfabric_app_authorization"
+}
+
+func (r *appAuthorizationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_bundle_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auth_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AuthType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auth_url": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"persona": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Persona](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"credential": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[credentialModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"api_key_credential": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[apiKeyCredentialModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"api_key": schema.StringAttribute{
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+						"oauth2_credential": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oauth2CredentialModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrClientID: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrClientSecret: schema.StringAttribute{
+										Required:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.ExactlyOneOf(
+							path.MatchRelative().AtName("api_key_credential"),
+							path.MatchRelative().AtName("oauth2_credential"),
+						),
+					},
+				},
+			},
+			"tenant": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tenantModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"tenant_display_name": schema.StringAttribute{
+							Required: true,
+						},
+						"tenant_identifier": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *appAuthorizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appAuthorizationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	input := &appfabric.CreateAppAuthorizationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	input.AppBundleIdentifier = fwflex.StringFromFramework(ctx, data.AppBundleARN)
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Credential = expandCredential(ctx, data.Credential)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateAppAuthorization(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating AppFabric App Authorization (%s)", data.App.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.AppAuthorization, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.AppAuthorization.AppAuthorizationArn)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *appAuthorizationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appAuthorizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	output, err := findAppAuthorizationByTwoPartKey(ctx, conn, data.ARN.ValueString(), data.AppBundleARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFabric App Authorization (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Credentials are write-only and are never returned by the API, so the configured values are kept in state.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *appAuthorizationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new appAuthorizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	if !new.Credential.Equal(old.Credential) || !new.Tenant.Equal(old.Tenant) {
+		input := &appfabric.UpdateAppAuthorizationInput{
+			AppAuthorizationIdentifier: fwflex.StringFromFramework(ctx, new.ARN),
+			AppBundleIdentifier:        fwflex.StringFromFramework(ctx, new.AppBundleARN),
+		}
+
+		if !new.Tenant.Equal(old.Tenant) {
+			tenantData, diags := new.Tenant.ToPtr(ctx)
+			response.Diagnostics.Append(diags...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			input.Tenant = &awstypes.Tenant{}
+			response.Diagnostics.Append(fwflex.Expand(ctx, tenantData, input.Tenant)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		if !new.Credential.Equal(old.Credential) {
+			// AutoFlEx doesn't yet handle union types.
+			input.Credential = expandCredential(ctx, new.Credential)
+		}
+
+		output, err := conn.UpdateAppAuthorization(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating AppFabric App Authorization (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdatedAt = timetypes.NewRFC3339TimePointerValue(output.AppAuthorization.UpdatedAt)
+	} else {
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *appAuthorizationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data appAuthorizationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	_, err := conn.DeleteAppAuthorization(ctx, &appfabric.DeleteAppAuthorizationInput{
+		AppAuthorizationIdentifier: fwflex.StringFromFramework(ctx, data.ARN),
+		AppBundleIdentifier:        fwflex.StringFromFramework(ctx, data.AppBundleARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting AppFabric App Authorization (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *appAuthorizationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAppAuthorizationByTwoPartKey(ctx context.Context, conn *appfabric.Client, appAuthorizationARN, appBundleARN string) (*awstypes.AppAuthorization, error) {
+	input := &appfabric.GetAppAuthorizationInput{
+		AppAuthorizationIdentifier: aws.String(appAuthorizationARN),
+		AppBundleIdentifier:        aws.String(appBundleARN),
+	}
+
+	output, err := conn.GetAppAuthorization(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AppAuthorization == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AppAuthorization, nil
+}
+
+func expandCredential(ctx context.Context, v fwtypes.ListNestedObjectValueOf[credentialModel]) awstypes.Credential {
+	credentialData := fwdiag.Must(v.ToPtr(ctx))
+
+	if credentialData == nil {
+		return nil
+	}
+
+	if apiKeyCredentialData := fwdiag.Must(credentialData.APIKeyCredential.ToPtr(ctx)); apiKeyCredentialData != nil {
+		return &awstypes.CredentialMemberApiKeyCredential{
+			Value: awstypes.ApiKeyCredential{
+				ApiKey: fwflex.StringFromFramework(ctx, apiKeyCredentialData.APIKey),
+			},
+		}
+	}
+
+	if oauth2CredentialData := fwdiag.Must(credentialData.OAuth2Credential.ToPtr(ctx)); oauth2CredentialData != nil {
+		return &awstypes.CredentialMemberOauth2Credential{
+			Value: awstypes.Oauth2Credential{
+				ClientId:     fwflex.StringFromFramework(ctx, oauth2CredentialData.ClientID),
+				ClientSecret: fwflex.StringFromFramework(ctx, oauth2CredentialData.ClientSecret),
+			},
+		}
+	}
+
+	return nil
+}
+
+type appAuthorizationResourceModel struct {
+	App          types.String                                     `tfsdk:"app"`
+	AppBundleARN fwtypes.ARN                                      `tfsdk:"app_bundle_arn"`
+	ARN          types.String                                     `tfsdk:"arn"`
+	AuthType     fwtypes.StringEnum[awstypes.AuthType]            `tfsdk:"auth_type"`
+	AuthURL      types.String                                     `tfsdk:"auth_url"`
+	CreatedAt    timetypes.RFC3339                                `tfsdk:"created_at"`
+	Credential   fwtypes.ListNestedObjectValueOf[credentialModel] `tfsdk:"credential"`
+	ID           types.String                                     `tfsdk:"id"`
+	Persona      fwtypes.StringEnum[awstypes.Persona]             `tfsdk:"persona"`
+	Tags         types.Map                                        `tfsdk:"tags"`
+	TagsAll      types.Map                                        `tfsdk:"tags_all"`
+	Tenant       fwtypes.ListNestedObjectValueOf[tenantModel]     `tfsdk:"tenant"`
+	UpdatedAt    timetypes.RFC3339                                `tfsdk:"updated_at"`
+}
+
+const (
+	appAuthorizationResourceIDPartCount = 2
+)
+
+func (m *appAuthorizationResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, appAuthorizationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.ARN = types.StringValue(parts[0])
+	m.AppBundleARN = fwtypes.ARNValue(parts[1])
+
+	return nil
+}
+
+func (m *appAuthorizationResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.ARN.ValueString(), m.AppBundleARN.ValueString()}, appAuthorizationResourceIDPartCount, false)))
+}
+
+type credentialModel struct {
+	APIKeyCredential fwtypes.ListNestedObjectValueOf[apiKeyCredentialModel] `tfsdk:"api_key_credential"`
+	OAuth2Credential fwtypes.ListNestedObjectValueOf[oauth2CredentialModel] `tfsdk:"oauth2_credential"`
+}
+
+type apiKeyCredentialModel struct {
+	APIKey types.String `tfsdk:"api_key"`
+}
+
+type oauth2CredentialModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+}
+
+type tenantModel struct {
+	TenantDisplayName types.String `tfsdk:"tenant_display_name"`
+	TenantIdentifier  types.String `tfsdk:"tenant_identifier"`
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAppAuthorizationConnection_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_appfabric_app_authorization_connection.test"
+	appAuthorizationResourceName := "aws_appfabric_app_authorization.test"
+	appBundleResourceName := "aws_appfabric_app_bundle.test"
+	// A valid Terraform Cloud API token is required to connect the app authorization.
+	apiKey := acctest.SkipIfEnvVarNotSet(t, "AWS_APPFABRIC_TERRAFORMCLOUD_API_KEY")
+	tenantID := acctest.SkipIfEnvVarNotSet(t, "AWS_APPFABRIC_TERRAFORMCLOUD_TENANT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppFabricServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAppAuthorizationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppAuthorizationConnectionConfig_basic(apiKey, tenantID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "app", "TERRAFORMCLOUD"),
+					resource.TestCheckResourceAttrPair(resourceName, "app_authorization_arn", appAuthorizationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "app_bundle_arn", appBundleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.AppAuthorizationStatusConnected)),
+					resource.TestCheckResourceAttr(resourceName, "tenant.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tenant.0.tenant_identifier", tenantID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAppAuthorizationConnectionConfig_basic(apiKey, tenantID string) string {
+	return acctest.ConfigCompose(testAccAppBundleConfig_basic, fmt.Sprintf(`
+resource "aws_appfabric_app_authorization" "test" {
+  app            = "TERRAFORMCLOUD"
+  app_bundle_arn = aws_appfabric_app_bundle.test.arn
+  auth_type      = "apiKey"
+
+  credential {
+    api_key_credential {
+      api_key = %[1]q
+    }
+  }
+
+  tenant {
+    tenant_display_name = "test"
+    tenant_identifier   = %[2]q
+  }
+}
+
+resource "aws_appfabric_app_authorization_connection" "test" {
+  app_authorization_arn = aws_appfabric_app_authorization.test.arn
+  app_bundle_arn        = aws_appfabric_app_bundle.test.arn
+}
+`, apiKey, tenantID))
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAppFabric_serial(t *testing.T) {
+	t.Parallel()
+
+	// Only one app bundle may exist per account and Region.
+	testCases := map[string]map[string]func(t *testing.T){
+		"AppBundle": {
+			acctest.CtBasic: testAccAppBundle_basic,
+			"disappears":    testAccAppBundle_disappears,
+			"tags":          testAccAppBundle_tags,
+		},
+		"AppAuthorization": {
+			acctest.CtBasic: testAccAppAuthorization_basic,
+			"disappears":    testAccAppAuthorization_disappears,
+			"update":        testAccAppAuthorization_update,
+		},
+		"AppAuthorizationConnection": {
+			acctest.CtBasic: testAccAppAuthorizationConnection_basic,
+		},
+		"Ingestion": {
+			acctest.CtBasic: testAccIngestion_basic,
+			"disappears":    testAccIngestion_disappears,
+		},
+		"IngestionDestination": {
+			acctest.CtBasic: testAccIngestionDestination_basic,
+			"disappears":    testAccIngestionDestination_disappears,
+			"update":        testAccIngestionDestination_update,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
+
+func testAccPreCheck(t *testing.T) {
+	acctest.PreCheckRegion(t, names.APNortheast1RegionID, names.EUWest1RegionID, names.USEast1RegionID)
+}
@@ -0,0 +1,594 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appfabric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appfabric"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Ingestion Destination")
+// @Tags(identifierAttribute="arn")
+func newIngestionDestinationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ingestionDestinationResource{}
+
+	return r, nil
+}
+
+type ingestionDestinationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*ingestionDestinationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_appfabric_ingestion_destination"
+}
+
+func (r *ingestionDestinationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_bundle_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"ingestion_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.IngestionDestinationStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"destination_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[destinationConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"audit_log": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[auditLogDestinationConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrDestination: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[destinationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"firehose_stream": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[firehoseStreamModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"stream_name": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"s3_bucket": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[s3BucketModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrBucketName: schema.StringAttribute{
+																Required: true,
+															},
+															names.AttrPrefix: schema.StringAttribute{
+																Optional: true,
+															},
+														},
+													},
+												},
+											},
+											Validators: []validator.Object{
+												objectvalidator.ExactlyOneOf(
+													path.MatchRelative().AtName("firehose_stream"),
+													path.MatchRelative().AtName("s3_bucket"),
+												),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"processing_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[processingConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"audit_log": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[auditLogProcessingConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrFormat: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Format](),
+										Required:   true,
+									},
+									names.AttrSchema: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Schema](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *ingestionDestinationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	// AutoFlEx doesn't yet handle union types.
+	input := &appfabric.CreateIngestionDestinationInput{
+		AppBundleIdentifier: fwflex.StringFromFramework(ctx, data.AppBundleARN),
+		ClientToken:         aws.String(id.UniqueId()),
+		IngestionIdentifier: fwflex.StringFromFramework(ctx, data.IngestionARN),
+		Tags:                getTagsIn(ctx),
+	}
+
+	destinationConfiguration, diags := expandDestinationConfiguration(ctx, data.DestinationConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DestinationConfiguration = destinationConfiguration
+
+	processingConfiguration, diags := expandProcessingConfiguration(ctx, data.ProcessingConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.ProcessingConfiguration = processingConfiguration
+
+	output, err := conn.CreateIngestionDestination(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating AppFabric Ingestion Destination", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, output.IngestionDestination.Arn)
+	data.Status = fwtypes.StringEnumValue(output.IngestionDestination.Status)
+	data.setID()
+
+	if err := statusReasonError(output.IngestionDestination); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("creating AppFabric Ingestion Destination (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingestionDestinationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	output, err := findIngestionDestinationByThreePartKey(ctx, conn, data.ARN.ValueString(), data.IngestionARN.ValueString(), data.AppBundleARN.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading AppFabric Ingestion Destination (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	destinationConfiguration, diags := flattenDestinationConfiguration(ctx, output.DestinationConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.DestinationConfiguration = destinationConfiguration
+
+	processingConfiguration, diags := flattenProcessingConfiguration(ctx, output.ProcessingConfiguration)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ProcessingConfiguration = processingConfiguration
+
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ingestionDestinationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	if !new.DestinationConfiguration.Equal(old.DestinationConfiguration) {
+		input := &appfabric.UpdateIngestionDestinationInput{
+			AppBundleIdentifier:            fwflex.StringFromFramework(ctx, new.AppBundleARN),
+			IngestionDestinationIdentifier: fwflex.StringFromFramework(ctx, new.ARN),
+			IngestionIdentifier:            fwflex.StringFromFramework(ctx, new.IngestionARN),
+		}
+
+		// AutoFlEx doesn't yet handle union types.
+		destinationConfiguration, diags := expandDestinationConfiguration(ctx, new.DestinationConfiguration)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.DestinationConfiguration = destinationConfiguration
+
+		output, err := conn.UpdateIngestionDestination(ctx, input)
+
+		if err == nil {
+			err = statusReasonError(output.IngestionDestination)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating AppFabric Ingestion Destination (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.IngestionDestination.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ingestionDestinationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ingestionDestinationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().AppFabricClient(ctx)
+
+	_, err := conn.DeleteIngestionDestination(ctx, &appfabric.DeleteIngestionDestinationInput{
+		AppBundleIdentifier:            fwflex.StringFromFramework(ctx, data.AppBundleARN),
+		IngestionDestinationIdentifier: fwflex.StringFromFramework(ctx, data.ARN),
+		IngestionIdentifier:            fwflex.StringFromFramework(ctx, data.IngestionARN),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting AppFabric Ingestion Destination (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ingestionDestinationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findIngestionDestinationByThreePartKey(ctx context.Context, conn *appfabric.Client, ingestionDestinationARN, ingestionARN, appBundleARN string) (*awstypes.IngestionDestination, error) {
+	input := &appfabric.GetIngestionDestinationInput{
+		AppBundleIdentifier:            aws.String(appBundleARN),
+		IngestionDestinationIdentifier: aws.String(ingestionDestinationARN),
+		IngestionIdentifier:            aws.String(ingestionARN),
+	}
+
+	output, err := conn.GetIngestionDestination(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.IngestionDestination == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.IngestionDestination, nil
+}
+
+func statusReasonError(v *awstypes.IngestionDestination) error {
+	if v == nil || v.Status != awstypes.IngestionDestinationStatusFailed {
+		return nil
+	}
+
+	if reason := aws.ToString(v.StatusReason); reason != "" {
+		return errors.New(reason)
+	}
+
+	return fmt.Errorf("unexpected status: %s", v.Status)
+}
+
+func expandDestinationConfiguration(ctx context.Context, v fwtypes.ListNestedObjectValueOf[destinationConfigurationModel]) (awstypes.DestinationConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	destinationConfigurationData, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || destinationConfigurationData == nil {
+		return nil, diags
+	}
+
+	auditLogData, d := destinationConfigurationData.AuditLog.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || auditLogData == nil {
+		return nil, diags
+	}
+
+	destinationData, d := auditLogData.Destination.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || destinationData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.DestinationConfigurationMemberAuditLog{}
+
+	if firehoseStreamData, d := destinationData.FirehoseStream.ToPtr(ctx); firehoseStreamData != nil {
+		diags.Append(d...)
+
+		destination := &awstypes.DestinationMemberFirehoseStream{}
+		diags.Append(fwflex.Expand(ctx, firehoseStreamData, &destination.Value)...)
+		apiObject.Value.Destination = destination
+	}
+
+	if s3BucketData, d := destinationData.S3Bucket.ToPtr(ctx); s3BucketData != nil {
+		diags.Append(d...)
+
+		destination := &awstypes.DestinationMemberS3Bucket{}
+		diags.Append(fwflex.Expand(ctx, s3BucketData, &destination.Value)...)
+		apiObject.Value.Destination = destination
+	}
+
+	return apiObject, diags
+}
+
+func flattenDestinationConfiguration(ctx context.Context, apiObject awstypes.DestinationConfiguration) (fwtypes.ListNestedObjectValueOf[destinationConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	auditLog, ok := apiObject.(*awstypes.DestinationConfigurationMemberAuditLog)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[destinationConfigurationModel](ctx), diags
+	}
+
+	var destinationData destinationModel
+
+	switch v := auditLog.Value.Destination.(type) {
+	case *awstypes.DestinationMemberFirehoseStream:
+		var firehoseStreamData firehoseStreamModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &firehoseStreamData)...)
+		destinationData.FirehoseStream = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &firehoseStreamData)
+		destinationData.S3Bucket = fwtypes.NewListNestedObjectValueOfNull[s3BucketModel](ctx)
+
+	case *awstypes.DestinationMemberS3Bucket:
+		var s3BucketData s3BucketModel
+		diags.Append(fwflex.Flatten(ctx, &v.Value, &s3BucketData)...)
+		destinationData.FirehoseStream = fwtypes.NewListNestedObjectValueOfNull[firehoseStreamModel](ctx)
+		destinationData.S3Bucket = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3BucketData)
+
+	default:
+		destinationData.FirehoseStream = fwtypes.NewListNestedObjectValueOfNull[firehoseStreamModel](ctx)
+		destinationData.S3Bucket = fwtypes.NewListNestedObjectValueOfNull[s3BucketModel](ctx)
+	}
+
+	auditLogData := &auditLogDestinationConfigurationModel{
+		Destination: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &destinationData),
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &destinationConfigurationModel{
+		AuditLog: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, auditLogData),
+	}), diags
+}
+
+func expandProcessingConfiguration(ctx context.Context, v fwtypes.ListNestedObjectValueOf[processingConfigurationModel]) (awstypes.ProcessingConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	processingConfigurationData, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || processingConfigurationData == nil {
+		return nil, diags
+	}
+
+	auditLogData, d := processingConfigurationData.AuditLog.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || auditLogData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.ProcessingConfigurationMemberAuditLog{}
+	diags.Append(fwflex.Expand(ctx, auditLogData, &apiObject.Value)...)
+
+	return apiObject, diags
+}
+
+func flattenProcessingConfiguration(ctx context.Context, apiObject awstypes.ProcessingConfiguration) (fwtypes.ListNestedObjectValueOf[processingConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	auditLog, ok := apiObject.(*awstypes.ProcessingConfigurationMemberAuditLog)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[processingConfigurationModel](ctx), diags
+	}
+
+	var auditLogData auditLogProcessingConfigurationModel
+	diags.Append(fwflex.Flatten(ctx, &auditLog.Value, &auditLogData)...)
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &processingConfigurationModel{
+		AuditLog: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &auditLogData),
+	}), diags
+}
+
+type ingestionDestinationResourceModel struct {
+	AppBundleARN             fwtypes.ARN                                                    `tfsdk:"app_bundle_arn"`
+	ARN                      types.String                                                   `tfsdk:"arn"`
+	DestinationConfiguration fwtypes.ListNestedObjectValueOf[destinationConfigurationModel] `tfsdk:"destination_configuration"`
+	ID                       types.String                                                   `tfsdk:"id"`
+	IngestionARN             fwtypes.ARN                                                    `tfsdk:"ingestion_arn"`
+	ProcessingConfiguration  fwtypes.ListNestedObjectValueOf[processingConfigurationModel]  `tfsdk:"processing_configuration"`
+	Status                   fwtypes.StringEnum[awstypes.IngestionDestinationStatus]        `tfsdk:"status"`
+	Tags                     types.Map                                                      `tfsdk:"tags"`
+	TagsAll                  types.Map                                                      `tfsdk:"tags_all"`
+}
+
+const (
+	ingestionDestinationResourceIDPartCount = 3
+)
+
+func (m *ingestionDestinationResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, ingestionDestinationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.ARN = types.StringValue(parts[0])
+	m.IngestionARN = fwtypes.ARNValue(parts[1])
+	m.AppBundleARN = fwtypes.ARNValue(parts[2])
+
+	return nil
+}
+
+func (m *ingestionDestinationResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.ARN.ValueString(), m.IngestionARN.ValueString(), m.AppBundleARN.ValueString()}, ingestionDestinationResourceIDPartCount, false)))
+}
+
+type destinationConfigurationModel struct {
+	AuditLog fwtypes.ListNestedObjectValueOf[auditLogDestinationConfigurationModel] `tfsdk:"audit_log"`
+}
+
+type auditLogDestinationConfigurationModel struct {
+	Destination fwtypes.ListNestedObjectValueOf[destinationModel] `tfsdk:"destination"`
+}
+
+type destinationModel struct {
+	FirehoseStream fwtypes.ListNestedObjectValueOf[firehoseStreamModel] `tfsdk:"firehose_stream"`
+	S3Bucket       fwtypes.ListNestedObjectValueOf[s3BucketModel]       `tfsdk:"s3_bucket"`
+}
+
+type firehoseStreamModel struct {
+	StreamName types.String `tfsdk:"stream_name"`
+}
+
+type s3BucketModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	Prefix     types.String `tfsdk:"prefix"`
+}
+
+type processingConfigurationModel struct {
+	AuditLog fwtypes.ListNestedObjectValueOf[auditLogProcessingConfigurationModel] `tfsdk:"audit_log"`
+}
+
+type auditLogProcessingConfigurationModel struct {
+	Format fwtypes.StringEnum[awstypes.Format] `tfsdk:"format"`
+	Schema fwtypes.StringEnum[awstypes.Schema] `tfsdk:"schema"`
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Guardrail")
+func newGuardrailDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &guardrailDataSource{}, nil
+}
+
+type guardrailDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *guardrailDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_guardrail"
+}
+
+func (d *guardrailDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blocked_input_messaging": schema.StringAttribute{
+				Computed: true,
+			},
+			"blocked_outputs_messaging": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			"guardrail_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"guardrail_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GuardrailStatus](),
+				Computed:   true,
+			},
+			"status_reasons": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *guardrailDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data guardrailDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BedrockClient(ctx)
+
+	guardrailID, version := data.GuardrailID.ValueString(), guardrailDraftVersion
+	if !data.Version.IsNull() {
+		version = data.Version.ValueString()
+	}
+
+	output, err := findGuardrailByTwoPartKey(ctx, conn, guardrailID, version)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Guardrail (%s) version %s", guardrailID, version), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.GuardrailId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type guardrailDataSourceModel struct {
+	BlockedInputMessaging   types.String                                 `tfsdk:"blocked_input_messaging"`
+	BlockedOutputsMessaging types.String                                 `tfsdk:"blocked_outputs_messaging"`
+	CreatedAt               timetypes.RFC3339                            `tfsdk:"created_at"`
+	Description             types.String                                 `tfsdk:"description"`
+	GuardrailARN            types.String                                 `tfsdk:"guardrail_arn"`
+	GuardrailID             types.String                                 `tfsdk:"guardrail_id"`
+	ID                      types.String                                 `tfsdk:"id"`
+	KMSKeyARN               types.String                                 `tfsdk:"kms_key_arn"`
+	Name                    types.String                                 `tfsdk:"name"`
+	Status                  fwtypes.StringEnum[awstypes.GuardrailStatus] `tfsdk:"status"`
+	StatusReasons           fwtypes.ListValueOf[types.String]            `tfsdk:"status_reasons"`
+	UpdatedAt               timetypes.RFC3339                            `tfsdk:"updated_at"`
+	Version                 types.String                                 `tfsdk:"version"`
+}
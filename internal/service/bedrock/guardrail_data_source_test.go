@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockGuardrailDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+	datasourceName := "data.aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "blocked_input_messaging", datasourceName, "blocked_input_messaging"),
+					resource.TestCheckResourceAttrPair(resourceName, "blocked_outputs_messaging", datasourceName, "blocked_outputs_messaging"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrCreatedAt, datasourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_arn", datasourceName, "guardrail_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_id", datasourceName, "guardrail_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrName, datasourceName, names.AttrName),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttrSet(datasourceName, "updated_at"),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrVersion, "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrailDataSource_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail_version.test"
+	datasourceName := "data.aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailDataSourceConfig_version(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDescription, datasourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_arn", datasourceName, "guardrail_arn"),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVersion, datasourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailConfig_basic(rName), `
+data "aws_bedrock_guardrail" "test" {
+  guardrail_id = aws_bedrock_guardrail.test.guardrail_id
+}
+`)
+}
+
+func testAccGuardrailDataSourceConfig_version(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailVersionConfig_basic(rName, "first version", false), `
+data "aws_bedrock_guardrail" "test" {
+  guardrail_id = aws_bedrock_guardrail.test.guardrail_id
+  version      = aws_bedrock_guardrail_version.test.version
+}
+`)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Agent Versions")
+func newAgentVersionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &agentVersionsDataSource{}, nil
+}
+
+type agentVersionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*agentVersionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_agent_versions"
+}
+
+func (d *agentVersionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"agent_id": schema.StringAttribute{
+				Required: true,
+			},
+			"agent_version_summaries": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[agentVersionSummaryModel](ctx),
+				Computed:    true,
+				ElementType: fwtypes.NewObjectTypeOf[agentVersionSummaryModel](ctx),
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *agentVersionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data agentVersionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().BedrockAgentClient(ctx)
+
+	agentID := data.AgentID.ValueString()
+	input := &bedrockagent.ListAgentVersionsInput{
+		AgentId: aws.String(agentID),
+	}
+
+	var agentVersionSummaries []awstypes.AgentVersionSummary
+	pages := bedrockagent.NewListAgentVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("listing Bedrock Agent Agent (%s) versions", agentID), err.Error())
+
+			return
+		}
+
+		// The working draft can't be referenced from an alias's routing configuration.
+		agentVersionSummaries = append(agentVersionSummaries, tfslices.Filter(page.AgentVersionSummaries, func(v awstypes.AgentVersionSummary) bool {
+			return aws.ToString(v.AgentVersion) != agentVersionDraft
+		})...)
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, agentVersionSummaries, &data.AgentVersionSummaries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(agentID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type agentVersionsDataSourceModel struct {
+	AgentID               types.String                                              `tfsdk:"agent_id"`
+	AgentVersionSummaries fwtypes.ListNestedObjectValueOf[agentVersionSummaryModel] `tfsdk:"agent_version_summaries"`
+	ID                    types.String                                              `tfsdk:"id"`
+}
+
+type agentVersionSummaryModel struct {
+	AgentName              types.String                                                 `tfsdk:"agent_name"`
+	AgentStatus            fwtypes.StringEnum[awstypes.AgentStatus]                     `tfsdk:"agent_status"`
+	AgentVersion           types.String                                                 `tfsdk:"agent_version"`
+	CreatedAt              timetypes.RFC3339                                            `tfsdk:"created_at"`
+	Description            types.String                                                 `tfsdk:"description"`
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel] `tfsdk:"guardrail_configuration"`
+	UpdatedAt              timetypes.RFC3339                                            `tfsdk:"updated_at"`
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentAgentVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	datasourceName := "data.aws_bedrockagent_agent_versions.test"
+	agentResourceName := "aws_bedrockagent_agent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceName, "agent_id", agentResourceName, "agent_id"),
+					resource.TestCheckResourceAttr(datasourceName, "agent_version_summaries.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(datasourceName, "agent_version_summaries.0.agent_name", agentResourceName, "agent_name"),
+					resource.TestCheckResourceAttrSet(datasourceName, "agent_version_summaries.0.agent_status"),
+					resource.TestCheckResourceAttr(datasourceName, "agent_version_summaries.0.agent_version", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(datasourceName, "agent_version_summaries.0.created_at"),
+					resource.TestCheckResourceAttrSet(datasourceName, "agent_version_summaries.0.updated_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAgentVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAgentAliasConfig_basic(rName), `
+data "aws_bedrockagent_agent_versions" "test" {
+  agent_id = aws_bedrockagent_agent.test.agent_id
+
+  depends_on = [aws_bedrockagent_agent_alias.test]
+}
+`)
+}
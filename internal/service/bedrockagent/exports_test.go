@@ -10,6 +10,7 @@ var (
 	ResourceAgentAlias                    = newAgentAliasResource
 	ResourceAgentKnowledgeBaseAssociation = newAgentKnowledgeBaseAssociationResource
 	ResourceDataSource                    = newDataSourceResource
+	ResourceIngestionJob                  = newIngestionJobResource
 	ResourceKnowledgeBase                 = newKnowledgeBaseResource
 
 	FindAgentByID                                  = findAgentByID
@@ -17,5 +18,6 @@ var (
 	FindAgentAliasByTwoPartKey                     = findAgentAliasByTwoPartKey
 	FindAgentKnowledgeBaseAssociationByThreePartID = findAgentKnowledgeBaseAssociationByThreePartKey
 	FindDataSourceByTwoPartKey                     = findDataSourceByTwoPartKey
+	FindIngestionJobByThreePartKey                 = findIngestionJobByThreePartKey
 	FindKnowledgeBaseByID                          = findKnowledgeBaseByID
 )
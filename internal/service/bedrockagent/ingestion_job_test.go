@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Prerequisites:
+// * psql run via null_resource/provisioner "local-exec"
+// * jq for parsing output from aws cli to retrieve postgres password
+func testAccIngestionJob_basic(t *testing.T) {
+	acctest.SkipIfExeNotOnPath(t, "psql")
+	acctest.SkipIfExeNotOnPath(t, "jq")
+	acctest.SkipIfExeNotOnPath(t, "aws")
+
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var ingestionJob types.IngestionJob
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_ingestion_job.test"
+	foundationModel := "amazon.titan-embed-text-v1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "3.2.2",
+			},
+		},
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIngestionJobConfig_basic(rName, foundationModel, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionJobExists(ctx, resourceName, &ingestionJob),
+					resource.TestCheckResourceAttrPair(resourceName, "data_source_id", "aws_bedrockagent_data_source.test", "data_source_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingestion_job_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "knowledge_base_id", "aws_bedrockagent_knowledge_base.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "started_at"),
+					resource.TestCheckResourceAttr(resourceName, "statistics.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "statistics.0.number_of_documents_failed", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.IngestionJobStatusComplete)),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccIngestionJobConfig_basic(rName, foundationModel, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIngestionJobRecreated(ctx, resourceName, &ingestionJob),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.IngestionJobStatusComplete)),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIngestionJobExists(ctx context.Context, n string, v *types.IngestionJob) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindIngestionJobByThreePartKey(ctx, conn, rs.Primary.Attributes["ingestion_job_id"], rs.Primary.Attributes["data_source_id"], rs.Primary.Attributes["knowledge_base_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckIngestionJobRecreated(ctx context.Context, n string, v *types.IngestionJob) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var new types.IngestionJob
+
+		if err := testAccCheckIngestionJobExists(ctx, n, &new)(s); err != nil {
+			return err
+		}
+
+		if aws.ToString(new.IngestionJobId) == aws.ToString(v.IngestionJobId) {
+			return fmt.Errorf("Bedrock Agent Ingestion Job (%s) not re-run", aws.ToString(v.IngestionJobId))
+		}
+
+		return nil
+	}
+}
+
+func testAccIngestionJobConfig_basic(rName, embeddingModel, version string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_basic(rName, embeddingModel), fmt.Sprintf(`
+resource "aws_bedrockagent_ingestion_job" "test" {
+  knowledge_base_id = aws_bedrockagent_knowledge_base.test.id
+  data_source_id    = aws_bedrockagent_data_source.test.data_source_id
+
+  triggers = {
+    version = %[1]q
+  }
+}
+`, version))
+}
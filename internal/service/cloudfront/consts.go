@@ -20,6 +20,11 @@ const (
 	distributionStatusInProgress = "InProgress"
 )
 
+const (
+	invalidationStatusCompleted  = "Completed"
+	invalidationStatusInProgress = "InProgress"
+)
+
 const (
 	keyValueStoreStatusProvisioning = "PROVISIONING"
 	keyValueStoreStatusReady        = "READY"
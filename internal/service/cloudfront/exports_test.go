@@ -11,6 +11,7 @@ var (
 	ResourceFieldLevelEncryptionConfig  = resourceFieldLevelEncryptionConfig
 	ResourceFieldLevelEncryptionProfile = resourceFieldLevelEncryptionProfile
 	ResourceFunction                    = resourceFunction
+	ResourceInvalidation                = newInvalidationResource
 	ResourceKeyGroup                    = resourceKeyGroup
 	ResourceKeyValueStore               = newKeyValueStoreResource
 	ResourceMonitoringSubscription      = resourceMonitoringSubscription
@@ -27,6 +28,7 @@ var (
 	FindFieldLevelEncryptionConfigByID         = findFieldLevelEncryptionConfigByID
 	FindFieldLevelEncryptionProfileByID        = findFieldLevelEncryptionProfileByID
 	FindFunctionByTwoPartKey                   = findFunctionByTwoPartKey
+	FindInvalidationByTwoPartKey               = findInvalidationByTwoPartKey
 	FindKeyGroupByID                           = findKeyGroupByID
 	FindKeyValueStoreByName                    = findKeyValueStoreByName
 	FindMonitoringSubscriptionByDistributionID = findMonitoringSubscriptionByDistributionID
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Maximum number of paths in a single invalidation batch.
+	// See https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/cloudfront-limits.html#limits-invalidations.
+	invalidationPathsMaxBatchSize = 3000
+
+	invalidationResourceIDPartCount = 2
+)
+
+// @FrameworkResource(name="Invalidation")
+func newInvalidationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &invalidationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type invalidationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpUpdate[invalidationResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*invalidationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_invalidation"
+}
+
+func (r *invalidationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"caller_reference": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"distribution_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"invalidation_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"paths": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *invalidationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data invalidationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	distributionID := data.DistributionID.ValueString()
+	paths := fwflex.ExpandFrameworkStringValueList(ctx, data.Paths)
+	// CloudFront returns the existing invalidation for a reused caller reference, so each create needs a new one,
+	// e.g. for an invalidation to happen again when triggers change back to an earlier value.
+	callerReference := id.UniqueId()
+	id, err := flex.FlattenResourceId([]string{distributionID, callerReference}, invalidationResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating CloudFront Invalidation", err.Error())
+
+		return
+	}
+
+	// The timeout covers creating all the batches and waiting for them to complete.
+	deadline := tfresource.NewDeadline(r.CreateTimeout(ctx, data.Timeouts))
+	var invalidationIDs []string
+	batches := tfslices.Chunks(paths, invalidationPathsMaxBatchSize)
+	for i, batch := range batches {
+		// The caller reference makes retries within this create idempotent: CloudFront returns the existing invalidation rather than creating another.
+		ref := callerReference
+		if len(batches) > 1 {
+			ref = fmt.Sprintf("%s-%d", callerReference, i)
+		}
+
+		input := &cloudfront.CreateInvalidationInput{
+			DistributionId: aws.String(distributionID),
+			InvalidationBatch: &awstypes.InvalidationBatch{
+				CallerReference: aws.String(ref),
+				Paths: &awstypes.Paths{
+					Items:    batch,
+					Quantity: aws.Int32(int32(len(batch))),
+				},
+			},
+		}
+
+		// Later batches are throttled until enough of the paths in progress complete.
+		outputRaw, err := tfresource.RetryWhenIsA[*awstypes.TooManyInvalidationsInProgress](ctx, deadline.Remaining(), func() (interface{}, error) {
+			return conn.CreateInvalidation(ctx, input)
+		})
+
+		if err != nil {
+			if len(invalidationIDs) > 0 {
+				// Persist the batches already created so as to taint the resource.
+				data.CallerReference = types.StringValue(callerReference)
+				data.ID = types.StringValue(id)
+				data.InvalidationIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, invalidationIDs)
+				response.Diagnostics.Append(response.State.Set(ctx, data)...)
+			}
+
+			response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Invalidation (%s)", id), err.Error())
+
+			return
+		}
+
+		invalidationIDs = append(invalidationIDs, aws.ToString(outputRaw.(*cloudfront.CreateInvalidationOutput).Invalidation.Id))
+	}
+
+	// Set values for unknowns.
+	data.CallerReference = types.StringValue(callerReference)
+	data.ID = types.StringValue(id)
+	data.InvalidationIDs = fwflex.FlattenFrameworkStringValueListOfString(ctx, invalidationIDs)
+
+	if data.WaitForCompletion.ValueBool() {
+		for _, invalidationID := range invalidationIDs {
+			if _, err := waitInvalidationCompleted(ctx, conn, distributionID, invalidationID, deadline.Remaining()); err != nil {
+				response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+				response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Invalidation (%s) create", invalidationID), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *invalidationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data invalidationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	distributionID := data.DistributionID.ValueString()
+	for _, invalidationID := range fwflex.ExpandFrameworkStringValueList(ctx, data.InvalidationIDs) {
+		_, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if tfresource.NotFound(err) {
+			response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+			response.State.RemoveResource(ctx)
+
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading CloudFront Invalidation (%s)", invalidationID), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, id string) (*awstypes.Invalidation, error) {
+	input := &cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(id),
+	}
+
+	output, err := conn.GetInvalidation(ctx, input)
+
+	if errs.IsA[*awstypes.NoSuchDistribution](err) || errs.IsA[*awstypes.NoSuchInvalidation](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+func statusInvalidation(ctx context.Context, conn *cloudfront.Client, distributionID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, id string, timeout time.Duration) (*awstypes.Invalidation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{invalidationStatusInProgress},
+		Target:     []string{invalidationStatusCompleted},
+		Refresh:    statusInvalidation(ctx, conn, distributionID, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type invalidationResourceModel struct {
+	CallerReference   types.String                      `tfsdk:"caller_reference"`
+	DistributionID    types.String                      `tfsdk:"distribution_id"`
+	ID                types.String                      `tfsdk:"id"`
+	InvalidationIDs   fwtypes.ListValueOf[types.String] `tfsdk:"invalidation_ids"`
+	Paths             fwtypes.ListValueOf[types.String] `tfsdk:"paths"`
+	Timeouts          timeouts.Value                    `tfsdk:"timeouts"`
+	Triggers          fwtypes.MapValueOf[types.String]  `tfsdk:"triggers"`
+	WaitForCompletion types.Bool                        `tfsdk:"wait_for_completion"`
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontInvalidation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var invalidation awstypes.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+	distributionResourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &invalidation),
+					resource.TestCheckResourceAttrSet(resourceName, "caller_reference"),
+					resource.TestCheckResourceAttrPair(resourceName, "distribution_id", distributionResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "invalidation_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "paths.0", "/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "paths.1", "/assets/*"),
+					resource.TestCheckNoResourceAttr(resourceName, "triggers"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontInvalidation_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var invalidation1, invalidation2, invalidation3 awstypes.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig_triggers("v1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &invalidation1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", "false"),
+				),
+			},
+			{
+				Config: testAccInvalidationConfig_triggers("v2", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &invalidation2),
+					testAccCheckInvalidationRecreated(&invalidation1, &invalidation2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "v2"),
+				),
+			},
+			{
+				// Reverting the triggers invalidates again.
+				Config: testAccInvalidationConfig_triggers("v1", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &invalidation3),
+					testAccCheckInvalidationRecreated(&invalidation1, &invalidation3),
+					testAccCheckInvalidationRecreated(&invalidation2, &invalidation3),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "v1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontInvalidation_batches(t *testing.T) {
+	ctx := acctest.Context(t)
+	var invalidation awstypes.Invalidation
+	resourceName := "aws_cloudfront_invalidation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvalidationConfig_batches(3001),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvalidationExists(ctx, resourceName, &invalidation),
+					resource.TestCheckResourceAttr(resourceName, "invalidation_ids.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "3001"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInvalidationExists(ctx context.Context, n string, v *awstypes.Invalidation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		output, err := tfcloudfront.FindInvalidationByTwoPartKey(ctx, conn, rs.Primary.Attributes["distribution_id"], rs.Primary.Attributes["invalidation_ids.0"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInvalidationRecreated(before, after *awstypes.Invalidation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.Id), aws.ToString(after.Id); before == after {
+			return fmt.Errorf("CloudFront Invalidation (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
+func testAccInvalidationConfig_basic() string {
+	return acctest.ConfigCompose(testAccDistributionConfig_enabled(false, false), `
+resource "aws_cloudfront_invalidation" "test" {
+  distribution_id = aws_cloudfront_distribution.test.id
+  paths           = ["/index.html", "/assets/*"]
+}
+`)
+}
+
+func testAccInvalidationConfig_triggers(release string, waitForCompletion bool) string {
+	return acctest.ConfigCompose(testAccDistributionConfig_enabled(false, false), fmt.Sprintf(`
+resource "aws_cloudfront_invalidation" "test" {
+  distribution_id     = aws_cloudfront_distribution.test.id
+  paths               = ["/*"]
+  wait_for_completion = %[2]t
+
+  triggers = {
+    release = %[1]q
+  }
+}
+`, release, waitForCompletion))
+}
+
+func testAccInvalidationConfig_batches(n int) string {
+	return acctest.ConfigCompose(testAccDistributionConfig_enabled(false, false), fmt.Sprintf(`
+resource "aws_cloudfront_invalidation" "test" {
+  distribution_id     = aws_cloudfront_distribution.test.id
+  paths               = [for i in range(%[1]d) : "/objects/${i}"]
+  wait_for_completion = false
+}
+`, n))
+}
@@ -24,6 +24,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newContinuousDeploymentPolicyResource,
 			Name:    "Continuous Deployment Policy",
 		},
+		{
+			Factory: newInvalidationResource,
+			Name:    "Invalidation",
+		},
 		{
 			Factory: newKeyValueStoreResource,
 			Name:    "Key Value Store",
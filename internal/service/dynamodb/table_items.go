@@ -0,0 +1,744 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	tableItemsBatchWriteMaxSize = 25
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	tableItemsBatchGetMaxSize = 100
+
+	tableItemsKeySeparator = "|"
+
+	// Binary precision used to canonicalize numbers, comfortably above DynamoDB's 38 decimal digits.
+	tableItemsNumberPrecision = 256
+)
+
+const (
+	tableItemsFormatCSV  = "csv"
+	tableItemsFormatJSON = "json"
+)
+
+func tableItemsFormat_Values() []string {
+	return []string{
+		tableItemsFormatCSV,
+		tableItemsFormatJSON,
+	}
+}
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: setTableItemsFromSource,
+
+		Schema: map[string]*schema.Schema{
+			"csv_attribute_types": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(tableItemsFormat_Values(), false),
+			},
+			"hash_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"items": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	items, err := loadTableItemsFromResourceData(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	tableName := d.Get(names.AttrTableName).(string)
+	requests := tfslices.ApplyToAll(tfmaps.Values(items), func(v map[string]awstypes.AttributeValue) awstypes.WriteRequest {
+		return awstypes.WriteRequest{PutRequest: &awstypes.PutRequest{Item: v}}
+	})
+
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+
+	// Read looks up the keys recorded in items, which are unknown during plan if source is not yet known.
+	hashes, err := tableItemsContentHashes(items)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	d.Set("items", hashes)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	table, err := findTableByName(ctx, conn, tableName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing Table Items from state", tableName)
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	hashKey, rangeKey := d.Get("hash_key").(string), d.Get("range_key").(string)
+	keys, err := expandTableItemsKeys(table, hashKey, rangeKey, tfmaps.Keys(d.Get("items").(map[string]interface{})))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	items, err := batchGetTableItems(ctx, conn, tableName, keys, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	// Items missing from the table drop out of the map and items changed outside Terraform get a different hash,
+	// so that the next plan writes them again.
+	hashes := make(map[string]string, len(items))
+	for _, item := range items {
+		key, err := tableItemsKey(item, hashKey, rangeKey)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		hash, err := tableItemContentHash(item)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		hashes[key] = hash
+	}
+
+	d.Set("items", hashes)
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChange("items") {
+		tableName := d.Get(names.AttrTableName).(string)
+
+		items, err := loadTableItemsFromResourceData(d)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		o, _ := d.GetChange("items")
+		old := o.(map[string]interface{})
+
+		var requests []awstypes.WriteRequest
+		for key, item := range items {
+			hash, err := tableItemContentHash(item)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			if v, ok := old[key]; ok && v.(string) == hash {
+				continue
+			}
+
+			requests = append(requests, awstypes.WriteRequest{PutRequest: &awstypes.PutRequest{Item: item}})
+		}
+
+		var removed []string
+		for key := range old {
+			if _, ok := items[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+
+		if len(removed) > 0 {
+			table, err := findTableByName(ctx, conn, tableName)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s): %s", tableName, err)
+			}
+
+			keys, err := expandTableItemsKeys(table, d.Get("hash_key").(string), d.Get("range_key").(string), removed)
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			for _, key := range keys {
+				requests = append(requests, awstypes.WriteRequest{DeleteRequest: &awstypes.DeleteRequest{Key: key}})
+			}
+		}
+
+		if err := batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", tableName, err)
+		}
+
+		hashes, err := tableItemsContentHashes(items)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		d.Set("items", hashes)
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	table, err := findTableByName(ctx, conn, tableName)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s): %s", tableName, err)
+	}
+
+	keys, err := expandTableItemsKeys(table, d.Get("hash_key").(string), d.Get("range_key").(string), tfmaps.Keys(d.Get("items").(map[string]interface{})))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting %d DynamoDB Table (%s) Items", len(keys), tableName)
+	requests := tfslices.ApplyToAll(keys, func(v map[string]awstypes.AttributeValue) awstypes.WriteRequest {
+		return awstypes.WriteRequest{DeleteRequest: &awstypes.DeleteRequest{Key: v}}
+	})
+
+	err = batchWriteTableItems(ctx, conn, tableName, requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	return diags
+}
+
+// setTableItemsFromSource sets items to the content hashes of the items in source,
+// so that plans show exactly which keys are added, changed or removed.
+func setTableItemsFromSource(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"csv_attribute_types", names.AttrFormat, "hash_key", "range_key", names.AttrSource} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("items")
+		}
+	}
+
+	source := d.Get(names.AttrSource).(string)
+	items, err := loadTableItems(source, d.Get(names.AttrFormat).(string), flex.ExpandStringValueMap(d.Get("csv_attribute_types").(map[string]interface{})), d.Get("hash_key").(string), d.Get("range_key").(string))
+	if err != nil {
+		return err
+	}
+
+	hashes, err := tableItemsContentHashes(items)
+	if err != nil {
+		return err
+	}
+
+	if old := flex.ExpandStringValueMap(d.Get("items").(map[string]interface{})); maps.Equal(old, hashes) {
+		return nil
+	}
+
+	return d.SetNew("items", hashes)
+}
+
+func loadTableItemsFromResourceData(d *schema.ResourceData) (map[string]map[string]awstypes.AttributeValue, error) {
+	return loadTableItems(d.Get(names.AttrSource).(string), d.Get(names.AttrFormat).(string), flex.ExpandStringValueMap(d.Get("csv_attribute_types").(map[string]interface{})), d.Get("hash_key").(string), d.Get("range_key").(string))
+}
+
+// loadTableItems reads the items in the file at path, keyed by their primary key.
+// If format is empty it is inferred from the file extension.
+func loadTableItems(path, format string, csvAttributeTypes map[string]string, hashKey, rangeKey string) (map[string]map[string]awstypes.AttributeValue, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == "" {
+		format = tableItemsFormatJSON
+		if strings.EqualFold(filepath.Ext(path), ".csv") {
+			format = tableItemsFormatCSV
+		}
+	}
+
+	var items []map[string]awstypes.AttributeValue
+
+	switch format {
+	case tableItemsFormatCSV:
+		items, err = parseTableItemsCSV(b, csvAttributeTypes)
+	default:
+		items, err = parseTableItemsJSON(b)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	output := make(map[string]map[string]awstypes.AttributeValue, len(items))
+	for i, item := range items {
+		key, err := tableItemsKey(item, hashKey, rangeKey)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: item %d: %w", path, i+1, err)
+		}
+
+		if _, ok := output[key]; ok {
+			return nil, fmt.Errorf("parsing %s: item %d: duplicate key %q", path, i+1, key)
+		}
+
+		output[key] = item
+	}
+
+	return output, nil
+}
+
+// parseTableItemsJSON parses items in DynamoDB JSON.
+// The input is either an array of items or a stream of items, each optionally wrapped in an "Item" object as in DynamoDB exports to S3.
+func parseTableItemsJSON(b []byte) ([]map[string]awstypes.AttributeValue, error) {
+	var raws []map[string]any
+
+	if b = bytes.TrimSpace(b); len(b) > 0 && b[0] == '[' {
+		if err := json.Unmarshal(b, &raws); err != nil {
+			return nil, err
+		}
+	} else {
+		dec := json.NewDecoder(bytes.NewReader(b))
+		for {
+			var m map[string]any
+			if err := dec.Decode(&m); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+
+			raws = append(raws, m)
+		}
+	}
+
+	items := make([]map[string]awstypes.AttributeValue, 0, len(raws))
+	for i, m := range raws {
+		if v, ok := m["Item"].(map[string]any); ok && len(m) == 1 {
+			if _, ok := v[dataTypeDescriptorMap]; !ok || len(v) != 1 {
+				m = v
+			}
+		}
+
+		item, err := tfmaps.ApplyToAllValuesWithError(m, attributeFromRaw)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i+1, err)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// parseTableItemsCSV parses items in CSV with a header row of attribute names.
+// Attributes are strings unless a type is set in attributeTypes. Empty cells are omitted.
+func parseTableItemsCSV(b []byte, attributeTypes map[string]string) ([]map[string]awstypes.AttributeValue, error) {
+	for name, typ := range attributeTypes {
+		if !slices.Contains([]string{dataTypeDescriptorBinary, dataTypeDescriptorBoolean, dataTypeDescriptorNumber, dataTypeDescriptorString}, typ) {
+			return nil, fmt.Errorf("unsupported type %q for CSV attribute %q", typ, name)
+		}
+	}
+
+	r := csv.NewReader(bytes.NewReader(b))
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var items []map[string]awstypes.AttributeValue
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		item := make(map[string]awstypes.AttributeValue, len(record))
+		for i, v := range record {
+			if v == "" {
+				continue
+			}
+
+			name := header[i]
+			switch typ := attributeTypes[name]; typ {
+			case dataTypeDescriptorBinary:
+				b, err := itypes.Base64Decode(v)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: attribute %q: %w", len(items)+2, name, err)
+				}
+				item[name] = &awstypes.AttributeValueMemberB{Value: b}
+			case dataTypeDescriptorBoolean:
+				b, err := strconv.ParseBool(v)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: attribute %q: %w", len(items)+2, name, err)
+				}
+				item[name] = &awstypes.AttributeValueMemberBOOL{Value: b}
+			case dataTypeDescriptorNumber:
+				item[name] = &awstypes.AttributeValueMemberN{Value: v}
+			default:
+				item[name] = &awstypes.AttributeValueMemberS{Value: v}
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// tableItemsKey returns the string form of an item's primary key: the hash key value, followed by the range key value if any.
+func tableItemsKey(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, error) {
+	hashValue, err := tableItemsKeyValue(item, hashKey)
+	if err != nil {
+		return "", err
+	}
+
+	if rangeKey == "" {
+		return hashValue, nil
+	}
+
+	if strings.Contains(hashValue, tableItemsKeySeparator) {
+		return "", fmt.Errorf("hash key %q value %q contains %q", hashKey, hashValue, tableItemsKeySeparator)
+	}
+
+	rangeValue, err := tableItemsKeyValue(item, rangeKey)
+	if err != nil {
+		return "", err
+	}
+
+	return hashValue + tableItemsKeySeparator + rangeValue, nil
+}
+
+func tableItemsKeyValue(item map[string]awstypes.AttributeValue, name string) (string, error) {
+	switch v := item[name].(type) {
+	case nil:
+		return "", fmt.Errorf("missing key attribute %q", name)
+	case *awstypes.AttributeValueMemberB:
+		return itypes.Base64EncodeOnce(v.Value), nil
+	case *awstypes.AttributeValueMemberN:
+		return canonicalTableItemNumber(v.Value), nil
+	case *awstypes.AttributeValueMemberS:
+		return v.Value, nil
+	default:
+		return "", fmt.Errorf("unsupported type %T for key attribute %q", v, name)
+	}
+}
+
+// expandTableItemsKeys converts string keys back to primary keys using the key attribute types defined on the table.
+func expandTableItemsKeys(table *awstypes.TableDescription, hashKey, rangeKey string, keys []string) ([]map[string]awstypes.AttributeValue, error) {
+	types := make(map[string]awstypes.ScalarAttributeType, len(table.AttributeDefinitions))
+	for _, v := range table.AttributeDefinitions {
+		types[aws.ToString(v.AttributeName)] = v.AttributeType
+	}
+
+	apiObjects := make([]map[string]awstypes.AttributeValue, 0, len(keys))
+	for _, key := range keys {
+		hashValue, rangeValue := key, ""
+		if rangeKey != "" {
+			var found bool
+			hashValue, rangeValue, found = strings.Cut(key, tableItemsKeySeparator)
+			if !found {
+				return nil, fmt.Errorf("unexpected format for key (%s), expected <hash key value>%s<range key value>", key, tableItemsKeySeparator)
+			}
+		}
+
+		apiObject := make(map[string]awstypes.AttributeValue, 2)
+
+		v, err := expandTableItemsKeyValue(types[hashKey], hashValue)
+		if err != nil {
+			return nil, fmt.Errorf("key attribute %q: %w", hashKey, err)
+		}
+		apiObject[hashKey] = v
+
+		if rangeKey != "" {
+			v, err := expandTableItemsKeyValue(types[rangeKey], rangeValue)
+			if err != nil {
+				return nil, fmt.Errorf("key attribute %q: %w", rangeKey, err)
+			}
+			apiObject[rangeKey] = v
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandTableItemsKeyValue(typ awstypes.ScalarAttributeType, v string) (awstypes.AttributeValue, error) {
+	switch typ {
+	case awstypes.ScalarAttributeTypeB:
+		b, err := itypes.Base64Decode(v)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberB{Value: b}, nil
+	case awstypes.ScalarAttributeTypeN:
+		return &awstypes.AttributeValueMemberN{Value: v}, nil
+	case awstypes.ScalarAttributeTypeS:
+		return &awstypes.AttributeValueMemberS{Value: v}, nil
+	default:
+		return nil, fmt.Errorf("not defined on table")
+	}
+}
+
+func tableItemsContentHashes(items map[string]map[string]awstypes.AttributeValue) (map[string]string, error) {
+	return tfmaps.ApplyToAllValuesWithError(items, tableItemContentHash)
+}
+
+// tableItemContentHash returns the base64-encoded SHA-256 digest of the item's DynamoDB JSON.
+// Numbers are canonicalized and set members sorted first, as DynamoDB normalizes the former
+// (e.g. "1.50" is stored as "1.5") and does not preserve the order of the latter.
+func tableItemContentHash(item map[string]awstypes.AttributeValue) (string, error) {
+	m, err := tfmaps.ApplyToAllValuesWithError(item, rawFromAttribute)
+	if err != nil {
+		return "", err
+	}
+
+	// encoding/json sorts map keys, so the encoding is canonical once numbers and sets are.
+	b, err := json.Marshal(canonicalizeRawAttribute(m))
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+func canonicalizeRawAttribute(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for k, e := range v {
+			switch k {
+			case dataTypeDescriptorNumber:
+				if s, ok := e.(string); ok {
+					m[k] = canonicalTableItemNumber(s)
+					continue
+				}
+			case dataTypeDescriptorBinarySet, dataTypeDescriptorNumberSet, dataTypeDescriptorStringSet:
+				if s, ok := e.([]string); ok {
+					if k == dataTypeDescriptorNumberSet {
+						s = tfslices.ApplyToAll(s, canonicalTableItemNumber)
+					} else {
+						s = slices.Clone(s)
+					}
+					slices.Sort(s)
+					m[k] = s
+					continue
+				}
+			}
+			m[k] = canonicalizeRawAttribute(e)
+		}
+		return m
+	case []any:
+		return tfslices.ApplyToAll(v, canonicalizeRawAttribute)
+	default:
+		return v
+	}
+}
+
+// canonicalTableItemNumber returns the canonical form of a DynamoDB number, so that
+// equal values written differently (e.g. "007", "7.0" and "7e0") compare equal.
+// DynamoDB numbers have at most 38 significant digits, well within the precision used.
+// Values that don't parse are returned unchanged and left for DynamoDB to reject.
+func canonicalTableItemNumber(s string) string {
+	f, _, err := big.ParseFloat(strings.TrimSpace(s), 10, tableItemsNumberPrecision, big.ToNearestEven)
+	if err != nil {
+		return s
+	}
+
+	if f.Sign() == 0 {
+		return "0"
+	}
+
+	return f.Text('g', -1)
+}
+
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, chunk := range tfslices.Chunks(requests, tableItemsBatchWriteMaxSize) {
+		unprocessed := chunk
+
+		// Unprocessed items are usually the result of throttling, so back off before each retry.
+		for r := retry.BeginWithOptions(retry.Options{BackoffMinDuration: 100 * time.Millisecond, BackoffMultiplier: 2}); r.Continue(ctx); {
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]awstypes.WriteRequest{
+					tableName: unprocessed,
+				},
+			}
+
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			if unprocessed = output.UnprocessedItems[tableName]; len(unprocessed) == 0 {
+				break
+			}
+		}
+
+		if n := len(unprocessed); n > 0 {
+			return fmt.Errorf("%d items unprocessed: %w", n, ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+func batchGetTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue, timeout time.Duration) ([]map[string]awstypes.AttributeValue, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var output []map[string]awstypes.AttributeValue
+
+	for _, chunk := range tfslices.Chunks(keys, tableItemsBatchGetMaxSize) {
+		unprocessed := chunk
+
+		for r := retry.BeginWithOptions(retry.Options{BackoffMinDuration: 100 * time.Millisecond, BackoffMultiplier: 2}); r.Continue(ctx); {
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]awstypes.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           unprocessed,
+					},
+				},
+			}
+
+			page, err := conn.BatchGetItem(ctx, input)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				return nil, &sdkretry.NotFoundError{
+					LastError:   err,
+					LastRequest: input,
+				}
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, page.Responses[tableName]...)
+
+			if unprocessed = page.UnprocessedKeys[tableName].Keys; len(unprocessed) == 0 {
+				break
+			}
+		}
+
+		if n := len(unprocessed); n > 0 {
+			return nil, fmt.Errorf("%d keys unprocessed: %w", n, ctx.Err())
+		}
+	}
+
+	return output, nil
+}
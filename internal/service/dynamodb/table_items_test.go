@@ -0,0 +1,439 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestLoadTableItems(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		fileName          string
+		content           string
+		format            string
+		csvAttributeTypes map[string]string
+		rangeKey          string
+		expectedKeys      []string
+		expectedErr       *regexp.Regexp
+	}{
+		"json array": {
+			fileName:     "items.json",
+			content:      `[{"pk":{"S":"a"},"v":{"N":"1"}},{"pk":{"S":"b"}}]`,
+			expectedKeys: []string{"a", "b"},
+		},
+		"json stream": {
+			fileName: "items.json",
+			content: `{"pk":{"S":"a"}}
+{"pk":{"S":"b"}}
+`,
+			expectedKeys: []string{"a", "b"},
+		},
+		"json export": {
+			fileName: "items.json",
+			content: `{"Item":{"pk":{"S":"a"}}}
+{"Item":{"pk":{"S":"b"}}}
+`,
+			expectedKeys: []string{"a", "b"},
+		},
+		"json range key": {
+			fileName:     "items.json",
+			content:      `[{"pk":{"S":"a"},"sk":{"N":"1"}},{"pk":{"S":"a"},"sk":{"N":"2"}}]`,
+			rangeKey:     "sk",
+			expectedKeys: []string{"a|1", "a|2"},
+		},
+		"json number key": {
+			fileName:     "items.json",
+			content:      `[{"pk":{"S":"a"},"sk":{"N":"007"}},{"pk":{"S":"a"},"sk":{"N":"1.50"}}]`,
+			rangeKey:     "sk",
+			expectedKeys: []string{"a|1.5", "a|7"},
+		},
+		"json missing key": {
+			fileName:    "items.json",
+			content:     `[{"v":{"S":"a"}}]`,
+			expectedErr: regexache.MustCompile(`item 1: missing key attribute "pk"`),
+		},
+		"json duplicate key": {
+			fileName:    "items.json",
+			content:     `[{"pk":{"S":"a"}},{"pk":{"S":"a"}}]`,
+			expectedErr: regexache.MustCompile(`item 2: duplicate key "a"`),
+		},
+		"json duplicate number key": {
+			fileName:    "items.json",
+			content:     `[{"pk":{"N":"7"}},{"pk":{"N":"7.0"}}]`,
+			expectedErr: regexache.MustCompile(`item 2: duplicate key "7"`),
+		},
+		"json separator in hash key": {
+			fileName:    "items.json",
+			content:     `[{"pk":{"S":"a|b"},"sk":{"S":"c"}}]`,
+			rangeKey:    "sk",
+			expectedErr: regexache.MustCompile(`contains "\|"`),
+		},
+		"csv": {
+			fileName: "items.csv",
+			content: `pk,count,enabled
+a,1,true
+b,,false
+`,
+			csvAttributeTypes: map[string]string{"count": "N", "enabled": "BOOL"},
+			expectedKeys:      []string{"a", "b"},
+		},
+		"csv format override": {
+			fileName:     "items.txt",
+			content:      "pk\na\n",
+			format:       "csv",
+			expectedKeys: []string{"a"},
+		},
+		"csv unsupported type": {
+			fileName:          "items.csv",
+			content:           "pk,tags\na,x\n",
+			csvAttributeTypes: map[string]string{"tags": "SS"},
+			expectedErr:       regexache.MustCompile(`unsupported type "SS" for CSV attribute "tags"`),
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tc.fileName)
+			if err := os.WriteFile(path, []byte(tc.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			items, err := tfdynamodb.LoadTableItems(path, tc.format, tc.csvAttributeTypes, "pk", tc.rangeKey)
+
+			if tc.expectedErr != nil {
+				if err == nil || !tc.expectedErr.MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got %v", tc.expectedErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			keys := tfmaps.Keys(items)
+			slices.Sort(keys)
+
+			if !slices.Equal(keys, tc.expectedKeys) {
+				t.Errorf("expected keys %v, got %v", tc.expectedKeys, keys)
+			}
+		})
+	}
+}
+
+func TestTableItemContentHash(t *testing.T) {
+	t.Parallel()
+
+	item1 := map[string]awstypes.AttributeValue{
+		"pk":   &awstypes.AttributeValueMemberS{Value: "a"},
+		"tags": &awstypes.AttributeValueMemberSS{Value: []string{"x", "y"}},
+	}
+	item2 := map[string]awstypes.AttributeValue{
+		"tags": &awstypes.AttributeValueMemberSS{Value: []string{"y", "x"}},
+		"pk":   &awstypes.AttributeValueMemberS{Value: "a"},
+	}
+	item3 := map[string]awstypes.AttributeValue{
+		"pk":   &awstypes.AttributeValueMemberS{Value: "a"},
+		"tags": &awstypes.AttributeValueMemberSS{Value: []string{"x"}},
+	}
+
+	hash1, err := tfdynamodb.TableItemContentHash(item1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash2, err := tfdynamodb.TableItemContentHash(item2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash3, err := tfdynamodb.TableItemContentHash(item3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("expected equal hashes for items differing only in set order, got %s and %s", hash1, hash2)
+	}
+	if hash1 == hash3 {
+		t.Errorf("expected different hashes for different items, got %s", hash1)
+	}
+	if got := item2["tags"].(*awstypes.AttributeValueMemberSS).Value; got[0] != "y" {
+		t.Errorf("expected item to be unmodified, got %v", got)
+	}
+}
+
+func TestTableItemContentHashNumbers(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		local, remote awstypes.AttributeValue
+		expectEqual   bool
+	}{
+		"trailing zeros": {
+			local:       &awstypes.AttributeValueMemberN{Value: "1.50"},
+			remote:      &awstypes.AttributeValueMemberN{Value: "1.5"},
+			expectEqual: true,
+		},
+		"leading zeros": {
+			local:       &awstypes.AttributeValueMemberN{Value: "007"},
+			remote:      &awstypes.AttributeValueMemberN{Value: "7"},
+			expectEqual: true,
+		},
+		"exponent": {
+			local:       &awstypes.AttributeValueMemberN{Value: "1.2e3"},
+			remote:      &awstypes.AttributeValueMemberN{Value: "1200"},
+			expectEqual: true,
+		},
+		"negative zero": {
+			local:       &awstypes.AttributeValueMemberN{Value: "-0.0"},
+			remote:      &awstypes.AttributeValueMemberN{Value: "0"},
+			expectEqual: true,
+		},
+		"number set": {
+			local:       &awstypes.AttributeValueMemberNS{Value: []string{"10", "2.0"}},
+			remote:      &awstypes.AttributeValueMemberNS{Value: []string{"2", "10"}},
+			expectEqual: true,
+		},
+		"nested": {
+			local: &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+				&awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{"n": &awstypes.AttributeValueMemberN{Value: "0.10"}}},
+			}},
+			remote: &awstypes.AttributeValueMemberL{Value: []awstypes.AttributeValue{
+				&awstypes.AttributeValueMemberM{Value: map[string]awstypes.AttributeValue{"n": &awstypes.AttributeValueMemberN{Value: ".1"}}},
+			}},
+			expectEqual: true,
+		},
+		"different values": {
+			local:  &awstypes.AttributeValueMemberN{Value: "1.5"},
+			remote: &awstypes.AttributeValueMemberN{Value: "1.05"},
+		},
+		"high precision": {
+			local:  &awstypes.AttributeValueMemberN{Value: "0.12345678901234567890123456789012345678"},
+			remote: &awstypes.AttributeValueMemberN{Value: "0.12345678901234567890123456789012345679"},
+		},
+		"number and string": {
+			local:  &awstypes.AttributeValueMemberN{Value: "7"},
+			remote: &awstypes.AttributeValueMemberS{Value: "7"},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			local, err := tfdynamodb.TableItemContentHash(map[string]awstypes.AttributeValue{"v": tc.local})
+			if err != nil {
+				t.Fatal(err)
+			}
+			remote, err := tfdynamodb.TableItemContentHash(map[string]awstypes.AttributeValue{"v": tc.remote})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := local == remote; got != tc.expectEqual {
+				t.Errorf("expected equal hashes to be %t, got %s and %s", tc.expectEqual, local, remote)
+			}
+		})
+	}
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := filepath.Join(t.TempDir(), "items.json")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteTableItemsFile(t, source, `[
+  {"pk": {"S": "a"}, "value": {"N": "1"}},
+  {"pk": {"S": "b"}, "value": {"N": "2"}},
+  {"pk": {"S": "c"}, "value": {"N": "3"}}
+]`)
+				},
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "items.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "items.a"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.b"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.c"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteTableItemsFile(t, source, `[
+  {"pk": {"S": "a"}, "value": {"N": "1"}},
+  {"pk": {"S": "b"}, "value": {"N": "20"}},
+  {"pk": {"S": "d"}, "value": {"N": "4"}}
+]`)
+				},
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "items.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "items.a"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.b"),
+					resource.TestCheckNoResourceAttr(resourceName, "items.c"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_csvRangeKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := filepath.Join(t.TempDir(), "items.csv")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					testAccWriteTableItemsFile(t, source, `pk,sk,name,enabled
+a,1,first,true
+a,2,second,false
+b,1,third,
+`)
+				},
+				Config: testAccTableItemsConfig_csvRangeKey(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "items.%", acctest.Ct3),
+					resource.TestCheckResourceAttrSet(resourceName, "items.a|1"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.a|2"),
+					resource.TestCheckResourceAttrSet(resourceName, "items.b|1"),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_largeBatch(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := filepath.Join(t.TempDir(), "items.json")
+
+	const n = 1000
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					var content string
+					for i := 0; i < n; i++ {
+						content += fmt.Sprintf(`{"pk": {"S": "item-%[1]d"}, "value": {"N": "%[1]d"}}`+"\n", i)
+					}
+					testAccWriteTableItemsFile(t, source, content)
+				},
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, n),
+					resource.TestCheckResourceAttr(resourceName, "items.%", fmt.Sprint(n)),
+				),
+			},
+		},
+	})
+}
+
+func testAccWriteTableItemsFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccTableItemsConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  source     = %[2]q
+}
+`, rName, source)
+}
+
+func testAccTableItemsConfig_csvRangeKey(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  range_key  = aws_dynamodb_table.test.range_key
+  source     = %[2]q
+
+  csv_attribute_types = {
+    sk      = "N"
+    enabled = "BOOL"
+  }
+}
+`, rName, source)
+}
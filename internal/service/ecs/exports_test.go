@@ -6,4 +6,7 @@ package ecs
 // Exports for use in tests only.
 var (
 	ResourceTag = resourceTag
+
+	ServiceDeploymentFailedError = serviceDeploymentFailedError
+	StoppedTaskError             = stoppedTaskError
 )
@@ -7,9 +7,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -157,3 +159,98 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+func findServiceDeploymentByID(service *ecs.Service, id string) *ecs.Deployment {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Id) == id {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func findPrimaryServiceDeployment(service *ecs.Service) *ecs.Deployment {
+	for _, v := range service.Deployments {
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
+// findServiceStoppedTasks returns the service's stopped tasks, most recently stopped first.
+// If deploymentID is set, only tasks started by that deployment are returned.
+func findServiceStoppedTasks(ctx context.Context, conn *ecs.ECS, service *ecs.Service, deploymentID string) ([]*ecs.Task, error) {
+	listInput := &ecs.ListTasksInput{
+		Cluster:       service.ClusterArn,
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		ServiceName:   service.ServiceName,
+	}
+
+	listOutput, err := conn.ListTasksWithContext(ctx, listInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if listOutput == nil || len(listOutput.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Cluster: service.ClusterArn,
+		Tasks:   listOutput.TaskArns,
+	}
+
+	describeOutput, err := conn.DescribeTasksWithContext(ctx, describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	var tasks []*ecs.Task
+	for _, v := range describeOutput.Tasks {
+		if deploymentID != "" && aws.StringValue(v.StartedBy) != deploymentID {
+			continue
+		}
+
+		tasks = append(tasks, v)
+	}
+
+	slices.SortFunc(tasks, func(a, b *ecs.Task) int {
+		return aws.TimeValue(b.StoppedAt).Compare(aws.TimeValue(a.StoppedAt))
+	})
+
+	return tasks, nil
+}
+
+func findUnhealthyTargetHealthDescriptions(ctx context.Context, conn *elbv2.ELBV2, targetGroupARN string) ([]*elbv2.TargetHealthDescription, error) {
+	input := &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+	}
+
+	output, err := conn.DescribeTargetHealthWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	var targets []*elbv2.TargetHealthDescription
+	for _, v := range output.TargetHealthDescriptions {
+		if v.TargetHealth != nil && aws.StringValue(v.TargetHealth.State) == elbv2.TargetHealthStateEnumUnhealthy {
+			targets = append(targets, v)
+		}
+	}
+
+	return targets, nil
+}
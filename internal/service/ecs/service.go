@@ -513,6 +513,11 @@ func ResourceService() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_deployment_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"wait_for_steady_state": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -524,6 +529,7 @@ func ResourceService() *schema.Resource {
 			verify.SetTagsDiff,
 			capacityProviderStrategyCustomizeDiff,
 			triggersCustomizeDiff,
+			waitForDeploymentCompletionCustomizeDiff,
 		),
 	}
 }
@@ -667,11 +673,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Service.ServiceArn))
 
-	fn := waitServiceActive
-	if d.Get("wait_for_steady_state").(bool) {
-		fn = waitServiceStable
-	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := waitServiceDeployed(ctx, d, meta, output.Service, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -965,8 +967,10 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 
 		// Retry due to IAM eventual consistency
+		var output *ecs.UpdateServiceOutput
 		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
-			_, err := conn.UpdateServiceWithContext(ctx, input)
+			var err error
+			output, err = conn.UpdateServiceWithContext(ctx, input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -983,18 +987,14 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateServiceWithContext(ctx, input)
+			output, err = conn.UpdateServiceWithContext(ctx, input)
 		}
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s): %s", d.Id(), err)
 		}
 
-		fn := waitServiceActive
-		if d.Get("wait_for_steady_state").(bool) {
-			fn = waitServiceStable
-		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := waitServiceDeployed(ctx, d, meta, output.Service, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1091,6 +1091,35 @@ func resourceServiceImport(ctx context.Context, d *schema.ResourceData, meta int
 	return []*schema.ResourceData{d}, nil
 }
 
+// waitServiceDeployed waits for a created or updated ECS Service as configured by the
+// "wait_for_deployment_completion" and "wait_for_steady_state" arguments.
+func waitServiceDeployed(ctx context.Context, d *schema.ResourceData, meta interface{}, service *ecs.Service, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	cluster := d.Get("cluster").(string)
+
+	// The PRIMARY deployment returned by CreateService or UpdateService is the one this apply started.
+	var deploymentID string
+	if deployment := findPrimaryServiceDeployment(service); deployment != nil {
+		deploymentID = aws.StringValue(deployment.Id)
+	}
+
+	var err error
+	switch {
+	case d.Get("wait_for_deployment_completion").(bool):
+		if deploymentID == "" {
+			return errors.New("no PRIMARY deployment found")
+		}
+
+		_, err = waitServiceDeploymentCompleted(ctx, conn, meta.(*conns.AWSClient).ELBV2Conn(ctx), d.Id(), cluster, deploymentID, timeout)
+	case d.Get("wait_for_steady_state").(bool):
+		_, err = waitServiceStable(ctx, conn, meta.(*conns.AWSClient).ELBV2Conn(ctx), d.Id(), cluster, deploymentID, timeout)
+	default:
+		_, err = waitServiceActive(ctx, conn, d.Id(), cluster, timeout)
+	}
+
+	return err
+}
+
 func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// clears diff to avoid extraneous diffs but lets it pass for triggering update
 	fnd := false
@@ -1114,6 +1143,21 @@ func triggersCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	return nil
 }
 
+func waitForDeploymentCompletionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("wait_for_deployment_completion").(bool) {
+		return nil
+	}
+
+	// Deployment rollout state is only reported for the ECS deployment controller.
+	if v, ok := d.GetOk("deployment_controller"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if v := v.([]interface{})[0].(map[string]interface{})[names.AttrType].(string); v != ecs.DeploymentControllerTypeEcs {
+			return fmt.Errorf(`"wait_for_deployment_completion" requires deployment_controller type %q, got %q`, ecs.DeploymentControllerTypeEcs, v)
+		}
+	}
+
+	return nil
+}
+
 func capacityProviderStrategyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	// to be backward compatible, should ForceNew almost always (previous behavior), unless:
 	//   force_new_deployment is true and
@@ -31,6 +31,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCluster,
 			TypeName: "aws_ecs_cluster",
 		},
+		{
+			Factory:  dataSourceClusters,
+			TypeName: "aws_ecs_clusters",
+			Name:     "Clusters",
+		},
 		{
 			Factory:  DataSourceContainerDefinition,
 			TypeName: "aws_ecs_container_definition",
@@ -39,10 +44,20 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceService,
 			TypeName: "aws_ecs_service",
 		},
+		{
+			Factory:  dataSourceServices,
+			TypeName: "aws_ecs_services",
+			Name:     "Services",
+		},
 		{
 			Factory:  DataSourceTaskDefinition,
 			TypeName: "aws_ecs_task_definition",
 		},
+		{
+			Factory:  dataSourceTaskDefinitions,
+			TypeName: "aws_ecs_task_definitions",
+			Name:     "Task Definitions",
+		},
 		{
 			Factory:  DataSourceTaskExecution,
 			TypeName: "aws_ecs_task_execution",
@@ -88,6 +88,99 @@ func Test_GetClustereNameFromARN(t *testing.T) {
 	}
 }
 
+func TestServiceDeploymentFailedError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		deployments  []*ecs.Deployment
+		deploymentID string
+		expected     string
+	}{
+		"no deployments": {},
+		"in progress": {
+			deployments: []*ecs.Deployment{
+				{Id: aws.String("ecs-svc/1"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress), Status: aws.String("PRIMARY")},
+			},
+			deploymentID: "ecs-svc/1",
+		},
+		"failed": {
+			deployments: []*ecs.Deployment{
+				{Id: aws.String("ecs-svc/1"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."), Status: aws.String("PRIMARY")},
+			},
+			deploymentID: "ecs-svc/1",
+			expected:     "deployment (ecs-svc/1) failed: ECS deployment circuit breaker: tasks failed to start.",
+		},
+		"earlier failed deployment draining": {
+			deployments: []*ecs.Deployment{
+				{Id: aws.String("ecs-svc/2"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress), Status: aws.String("PRIMARY")},
+				{Id: aws.String("ecs-svc/1"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."), Status: aws.String("ACTIVE")},
+			},
+			deploymentID: "ecs-svc/2",
+		},
+		"rolled back": {
+			deployments: []*ecs.Deployment{
+				{Id: aws.String("ecs-svc/3"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), Status: aws.String("PRIMARY")},
+				{Id: aws.String("ecs-svc/2"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."), Status: aws.String("ACTIVE")},
+			},
+			deploymentID: "ecs-svc/2",
+			expected:     "deployment (ecs-svc/2) failed: ECS deployment circuit breaker: tasks failed to start.",
+		},
+		"rolled back and drained": {
+			deployments: []*ecs.Deployment{
+				{Id: aws.String("ecs-svc/3"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), Status: aws.String("PRIMARY")},
+			},
+			deploymentID: "ecs-svc/2",
+			expected:     "deployment (ecs-svc/2) no longer active",
+		},
+		"no deployment ID, primary failed": {
+			deployments: []*ecs.Deployment{
+				{Id: aws.String("ecs-svc/2"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start."), Status: aws.String("PRIMARY")},
+				{Id: aws.String("ecs-svc/1"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted), Status: aws.String("ACTIVE")},
+			},
+			expected: "deployment (ecs-svc/2) failed: ECS deployment circuit breaker: tasks failed to start.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfecs.ServiceDeploymentFailedError(&ecs.Service{Deployments: testCase.deployments}, testCase.deploymentID)
+
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestStoppedTaskError(t *testing.T) {
+	t.Parallel()
+
+	task := &ecs.Task{
+		Containers: []*ecs.Container{
+			{Name: aws.String("app"), ExitCode: aws.Int64(1)},
+			{Name: aws.String("sidecar")},
+			{Name: aws.String("init"), Reason: aws.String("CannotPullContainerError: pull image manifest has been retried 5 time(s)")},
+		},
+		StopCode:      aws.String(ecs.TaskStopCodeEssentialContainerExited),
+		StoppedReason: aws.String("Essential container in task exited"),
+		TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/test/0123456789abcdef"), //lintignore:AWSAT003,AWSAT005
+	}
+
+	//lintignore:AWSAT003,AWSAT005
+	want := "stopped task (arn:aws:ecs:us-west-2:123456789012:task/test/0123456789abcdef): Essential container in task exited (EssentialContainerExited); container app exit code 1; container init: CannotPullContainerError: pull image manifest has been retried 5 time(s)"
+
+	if got := tfecs.StoppedTaskError(task).Error(); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
 func TestAccECSService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -148,8 +241,8 @@ func TestAccECSService_basicImport(t *testing.T) {
 				ImportStateId:     importInput,
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_deployment_completion and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"wait_for_deployment_completion", "wait_for_steady_state"},
 			},
 			// Test non-existent resource import
 			{
@@ -433,8 +526,8 @@ func TestAccECSService_DeploymentControllerType_codeDeploy(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_deployment_completion and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_deployment_completion", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -508,8 +601,8 @@ func TestAccECSService_DeploymentControllerType_external(t *testing.T) {
 				ImportStateId:     fmt.Sprintf("%s/%s", rName, rName),
 				ImportState:       true,
 				ImportStateVerify: true,
-				// wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"wait_for_steady_state"},
+				// wait_for_deployment_completion and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"wait_for_deployment_completion", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -1028,6 +1121,34 @@ func TestAccECSService_LaunchTypeFargate_platformVersion(t *testing.T) {
 	})
 }
 
+func TestAccECSService_LaunchTypeFargate_waitForDeploymentCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_launchTypeFargateWaitForDeploymentCompletion(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_deployment_completion", "true"),
+				),
+			},
+			{
+				// Deploy a task definition whose container exits immediately; the circuit breaker fails the deployment.
+				Config:      testAccServiceConfig_launchTypeFargateWaitForDeploymentCompletion(rName, "crash"),
+				ExpectError: regexache.MustCompile(`deployment \(ecs-svc/\d+\) (failed|no longer active)`),
+			},
+		},
+	})
+}
+
 func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -1055,8 +1176,8 @@ func TestAccECSService_LaunchTypeFargate_waitForSteadyState(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_deployment_completion and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_deployment_completion", "wait_for_steady_state"},
 			},
 		},
 	})
@@ -1483,8 +1604,8 @@ func TestAccECSService_Tags_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				// Resource currently defaults to importing task_definition as family:revision
-				// and wait_for_steady_state is not read from API
-				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_steady_state"},
+				// and wait_for_deployment_completion and wait_for_steady_state are not read from API
+				ImportStateVerifyIgnore: []string{"task_definition", "wait_for_deployment_completion", "wait_for_steady_state"},
 			},
 			{
 				Config: testAccServiceConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
@@ -1889,6 +2010,46 @@ resource "aws_ecs_service" "test" {
 `, rName, desiredCount, waitForSteadyState))
 }
 
+func testAccServiceConfig_launchTypeFargateWaitForDeploymentCompletion(rName, taskDefinition string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_launchTypeFargateBase(rName), fmt.Sprintf(`
+resource "aws_ecs_task_definition" "crash" {
+  family                   = "%[1]s-crash"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = jsonencode([{
+    command   = ["false"]
+    essential = true
+    image     = "public.ecr.aws/docker/library/busybox:latest"
+    name      = "crash"
+  }])
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.%[2]s.arn
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  deployment_circuit_breaker {
+    enable   = true
+    rollback = true
+  }
+
+  network_configuration {
+    security_groups  = [aws_security_group.test[0].id]
+    subnets          = aws_subnet.test[*].id
+    assign_public_ip = true
+  }
+
+  wait_for_deployment_completion = true
+}
+`, rName, taskDefinition))
+}
+
 func testAccServiceConfig_interchangeablePlacementStrategy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecs_services", name="Services")
+func dataSourceServices() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServicesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"cluster_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"launch_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.LaunchType_Values(), false),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"scheduling_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	clusterARN := d.Get("cluster_arn").(string)
+	input := &ecs.ListServicesInput{
+		Cluster: aws.String(clusterARN),
+	}
+
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scheduling_strategy"); ok {
+		input.SchedulingStrategy = aws.String(v.(string))
+	}
+
+	var serviceARNs []string
+
+	err := listServicesPages(ctx, conn, input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		serviceARNs = append(serviceARNs, aws.StringValueSlice(page.ServiceArns)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing ECS Services (%s): %s", clusterARN, err)
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	var arns, serviceNames []string
+
+	for _, arn := range serviceARNs {
+		name := serviceNameFromARN(arn)
+
+		if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(name) {
+			continue
+		}
+
+		if len(tagsToMatch) > 0 {
+			tags, err := listTags(ctx, conn, arn)
+
+			if tfawserr.ErrCodeEquals(err, ecs.ErrCodeServiceNotFoundException) {
+				continue
+			}
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for ECS Service (%s): %s", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+		}
+
+		arns = append(arns, arn)
+		serviceNames = append(serviceNames, name)
+	}
+
+	d.SetId(clusterARN)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, serviceNames)
+
+	return diags
+}
+
+// serviceNameFromARN parses a service name from a fully qualified ARN in either the
+// short or the long (cluster name included) format:
+//
+//	arn:aws:ecs:us-west-2:0123456789:service/my-service
+//	arn:aws:ecs:us-west-2:0123456789:service/my-cluster/my-service
+func serviceNameFromARN(arn string) string {
+	parts := strings.Split(arn, "/")
+
+	return parts[len(parts)-1]
+}
@@ -5,6 +5,7 @@ package ecs
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
@@ -20,6 +21,8 @@ const (
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
 
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
@@ -71,7 +74,7 @@ func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string)
 	}
 }
 
-func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
+func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster, deploymentID string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(ctx, conn, id, cluster)()
 		if err != nil {
@@ -84,6 +87,10 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 
 		service := serviceRaw.(*ecs.Service)
 
+		if err := serviceDeploymentFailedError(service, deploymentID); err != nil {
+			return service, "", err
+		}
+
 		if d, dc, rc := len(service.Deployments),
 			aws.Int64Value(service.DesiredCount),
 			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
@@ -96,6 +103,33 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 	}
 }
 
+func statusServiceDeployment(ctx context.Context, conn *ecs.ECS, id, cluster, deploymentID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		service, err := FindServiceNoTagsByID(ctx, conn, id, cluster)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+
+		deployment := findServiceDeploymentByID(service, deploymentID)
+		if deployment == nil {
+			// The deployment is removed from the service once it has been replaced, e.g. by a circuit breaker rollback.
+			return service, "", fmt.Errorf("deployment (%s) no longer active", deploymentID)
+		}
+
+		switch rolloutState := aws.StringValue(deployment.RolloutState); rolloutState {
+		case "":
+			return service, "", fmt.Errorf("deployment (%s) has no rollout state; only the ECS deployment controller is supported", deploymentID)
+		case ecs.DeploymentRolloutStateFailed:
+			return service, rolloutState, deploymentFailedError(deployment)
+		default:
+			return service, rolloutState, nil
+		}
+	}
+}
+
 func stabilityStatusTaskSet(ctx context.Context, conn *ecs.ECS, taskSetID, service, cluster string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		input := &ecs.DescribeTaskSetsInput{
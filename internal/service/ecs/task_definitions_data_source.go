@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ecs_task_definitions", name="Task Definitions")
+func dataSourceTaskDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTaskDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"family_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrStatus: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ecs.TaskDefinitionStatusActive,
+				ValidateFunc: validation.StringInSlice(ecs.TaskDefinitionStatus_Values(), false),
+			},
+			names.AttrTags: tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceTaskDefinitionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ECSConn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ecs.ListTaskDefinitionsInput{
+		Status: aws.String(d.Get(names.AttrStatus).(string)),
+	}
+
+	if v, ok := d.GetOk("family_prefix"); ok {
+		input.FamilyPrefix = aws.String(v.(string))
+	}
+
+	var taskDefinitionARNs []string
+
+	err := listTaskDefinitionsPages(ctx, conn, input, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		taskDefinitionARNs = append(taskDefinitionARNs, aws.StringValueSlice(page.TaskDefinitionArns)...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing ECS Task Definitions: %s", err)
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	if len(tagsToMatch) > 0 {
+		var arns []string
+
+		for _, arn := range taskDefinitionARNs {
+			tags, err := listTags(ctx, conn, arn)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "listing tags for ECS Task Definition (%s): %s", arn, err)
+			}
+
+			if !tags.ContainsAll(tagsToMatch) {
+				continue
+			}
+
+			arns = append(arns, arn)
+		}
+
+		taskDefinitionARNs = arns
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, taskDefinitionARNs)
+
+	return diags
+}
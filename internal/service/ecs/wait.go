@@ -5,10 +5,15 @@ package ecs
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
@@ -31,6 +36,11 @@ const (
 	taskSetDeleteTimeout = 10 * time.Minute
 )
 
+const (
+	// Maximum number of each kind of diagnostic item (service events, stopped tasks, unhealthy targets) to report.
+	serviceDiagnosticsItems = 5
+)
+
 func waitCapacityProviderDeleted(ctx context.Context, conn *ecs.ECS, arn string) (*ecs.CapacityProvider, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{ecs.CapacityProviderStatusActive},
@@ -66,25 +76,44 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
-func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+// Fails early if the specified deployment (or, if none, the PRIMARY deployment) fails, e.g. is rolled back by the deployment circuit breaker.
+func waitServiceStable(ctx context.Context, conn *ecs.ECS, elbv2Conn *elbv2.ELBV2, id, cluster, deploymentID string, timeout time.Duration) (*ecs.Service, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
+		Target:  []string{serviceStatusStable},
+		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster, deploymentID),
+		Timeout: timeout,
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*ecs.Service); ok {
+		if err != nil {
+			err = errors.Join(err, serviceDiagnosticsError(ctx, conn, elbv2Conn, v, deploymentID))
+		}
+
+		return v, err
 	}
 
+	return nil, err
+}
+
+// waitServiceDeploymentCompleted waits for an ECS Service deployment to reach the rollout state "COMPLETED". Does not return tags.
+func waitServiceDeploymentCompleted(ctx context.Context, conn *ecs.ECS, elbv2Conn *elbv2.ELBV2, id, cluster, deploymentID string, timeout time.Duration) (*ecs.Service, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
-		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Pending: []string{ecs.DeploymentRolloutStateInProgress},
+		Target:  []string{ecs.DeploymentRolloutStateCompleted},
+		Refresh: statusServiceDeployment(ctx, conn, id, cluster, deploymentID),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
 	if v, ok := outputRaw.(*ecs.Service); ok {
+		if err != nil {
+			err = errors.Join(err, serviceDiagnosticsError(ctx, conn, elbv2Conn, v, deploymentID))
+		}
+
 		return v, err
 	}
 
@@ -157,3 +186,108 @@ func waitTaskSetDeleted(ctx context.Context, conn *ecs.ECS, taskSetID, service,
 
 	return err
 }
+
+// serviceDeploymentFailedError returns an error if the specified deployment has failed or been replaced.
+// A deployment rolled back by the circuit breaker stays ACTIVE, with a FAILED rollout state, while the
+// rollback deployment becomes PRIMARY, and is removed once drained.
+// If no deployment is specified, the service's PRIMARY deployment is checked.
+// Other failed deployments may remain ACTIVE while they drain and are ignored.
+func serviceDeploymentFailedError(service *ecs.Service, deploymentID string) error {
+	var deployment *ecs.Deployment
+	if deploymentID == "" {
+		deployment = findPrimaryServiceDeployment(service)
+	} else if deployment = findServiceDeploymentByID(service, deploymentID); deployment == nil {
+		return fmt.Errorf("deployment (%s) no longer active", deploymentID)
+	}
+
+	if deployment != nil && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+		return deploymentFailedError(deployment)
+	}
+
+	return nil
+}
+
+func deploymentFailedError(deployment *ecs.Deployment) error {
+	return fmt.Errorf("deployment (%s) failed: %s", aws.StringValue(deployment.Id), aws.StringValue(deployment.RolloutStateReason))
+}
+
+// serviceDiagnosticsError returns the latest service events, stopped task reasons and unhealthy load balancer targets
+// to help explain why an ECS Service did not reach the desired state.
+// Errors reading diagnostics are logged rather than returned so that they don't mask the original error.
+func serviceDiagnosticsError(ctx context.Context, conn *ecs.ECS, elbv2Conn *elbv2.ELBV2, service *ecs.Service, deploymentID string) error {
+	var errs []error
+
+	for _, v := range service.Events[:min(len(service.Events), serviceDiagnosticsItems)] {
+		errs = append(errs, fmt.Errorf("service event (%s): %s", aws.TimeValue(v.CreatedAt).Format(time.RFC3339), aws.StringValue(v.Message)))
+	}
+
+	tasks, err := findServiceStoppedTasks(ctx, conn, service, deploymentID)
+
+	if err != nil {
+		log.Printf("[WARN] reading ECS Service (%s) stopped tasks: %s", aws.StringValue(service.ServiceArn), err)
+	}
+
+	for _, v := range tasks[:min(len(tasks), serviceDiagnosticsItems)] {
+		errs = append(errs, stoppedTaskError(v))
+	}
+
+	for _, v := range service.LoadBalancers {
+		targetGroupARN := aws.StringValue(v.TargetGroupArn)
+		if targetGroupARN == "" {
+			continue
+		}
+
+		targets, err := findUnhealthyTargetHealthDescriptions(ctx, elbv2Conn, targetGroupARN)
+
+		if err != nil {
+			log.Printf("[WARN] reading ELBv2 Target Group (%s) target health: %s", targetGroupARN, err)
+			continue
+		}
+
+		for _, v := range targets[:min(len(targets), serviceDiagnosticsItems)] {
+			errs = append(errs, unhealthyTargetError(targetGroupARN, v))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func stoppedTaskError(task *ecs.Task) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "stopped task (%s): %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason))
+
+	if v := aws.StringValue(task.StopCode); v != "" {
+		fmt.Fprintf(&sb, " (%s)", v)
+	}
+
+	for _, v := range task.Containers {
+		if v.ExitCode == nil && v.Reason == nil {
+			continue
+		}
+
+		fmt.Fprintf(&sb, "; container %s", aws.StringValue(v.Name))
+
+		if v.ExitCode != nil {
+			fmt.Fprintf(&sb, " exit code %d", aws.Int64Value(v.ExitCode))
+		}
+
+		if v := aws.StringValue(v.Reason); v != "" {
+			fmt.Fprintf(&sb, ": %s", v)
+		}
+	}
+
+	return errors.New(sb.String())
+}
+
+func unhealthyTargetError(targetGroupARN string, target *elbv2.TargetHealthDescription) error {
+	var id string
+	if target.Target != nil {
+		id = aws.StringValue(target.Target.Id)
+		if v := aws.Int64Value(target.Target.Port); v != 0 {
+			id = fmt.Sprintf("%s:%d", id, v)
+		}
+	}
+
+	return fmt.Errorf("unhealthy target (%s) in target group (%s): %s: %s", id, targetGroupARN, aws.StringValue(target.TargetHealth.Reason), aws.StringValue(target.TargetHealth.Description))
+}
@@ -715,8 +715,8 @@ func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.Client, name,
 	return output.Update, nil
 }
 
-func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func statusCluster(ctx context.Context, conn *eks.Client, name string) tfresource.StatusFunc[types.Cluster] {
+	return func() (*types.Cluster, string, error) {
 		output, err := findClusterByName(ctx, conn, name)
 
 		if tfresource.NotFound(err) {
@@ -731,8 +731,8 @@ func statusCluster(ctx context.Context, conn *eks.Client, name string) retry.Sta
 	}
 }
 
-func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string) tfresource.StatusFunc[types.Update] {
+	return func() (*types.Update, string, error) {
 		output, err := findClusterUpdateByTwoPartKey(ctx, conn, name, id)
 
 		if tfresource.NotFound(err) {
@@ -748,58 +748,47 @@ func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string)
 }
 
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
-		Target:  enum.Slice(types.ClusterStatusActive),
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
+	stateWaiter := &tfresource.StateWaiter[types.Cluster]{
+		Pending:     enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
+		Target:      enum.Slice(types.ClusterStatusActive),
+		Refresh:     statusCluster(ctx, conn, name),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Cluster (%s)", name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Cluster); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
-		Target:  []string{},
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
+	stateWaiter := &tfresource.StateWaiter[types.Cluster]{
+		Pending:     enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
+		Target:      []string{},
+		Refresh:     statusCluster(ctx, conn, name),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Cluster (%s)", name),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*types.Cluster); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.UpdateStatusInProgress),
-		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusClusterUpdate(ctx, conn, name, id),
-		Timeout: timeout,
+	stateWaiter := &tfresource.StateWaiter[types.Update]{
+		Pending:     enum.Slice(types.UpdateStatusInProgress),
+		Target:      enum.Slice(types.UpdateStatusSuccessful),
+		Refresh:     statusClusterUpdate(ctx, conn, name, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("EKS Cluster (%s) update (%s)", name, id),
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	output, err := stateWaiter.WaitForState(ctx)
 
-	if output, ok := outputRaw.(*types.Update); ok {
+	if output != nil {
 		if status := output.Status; status == types.UpdateStatusCancelled || status == types.UpdateStatusFailed {
 			tfresource.SetLastError(err, errorDetailsError(output.Errors))
 		}
-
-		return output, err
 	}
 
-	return nil, err
+	return output, err
 }
 
 func expandCreateAccessConfigRequest(tfList []interface{}) *types.CreateAccessConfigRequest {
@@ -0,0 +1,627 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	gversion "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_eks_cluster_upgrade", name="Cluster Upgrade")
+func resourceClusterUpgrade() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterUpgradeCreate,
+		ReadWithoutTimeout:   resourceClusterUpgradeRead,
+		UpdateWithoutTimeout: resourceClusterUpgradeUpdate,
+		DeleteWithoutTimeout: resourceClusterUpgradeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addon_resolve_conflicts": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ResolveConflicts](),
+			},
+			"addon_versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validClusterName,
+			},
+			"force_node_group_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"node_group_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"node_group_versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"stop_on_insight_warnings": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"upgrade_addons": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"upgrade_node_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(clusterUpgradeVersionRegexp, "must be a Kubernetes minor version, for example 1.30"),
+			},
+		},
+
+		CustomizeDiff: resourceClusterUpgradeCustomizeDiff,
+	}
+}
+
+func resourceClusterUpgradeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+
+	if err := upgradeCluster(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "upgrading EKS Cluster (%s): %s", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+
+	return append(diags, resourceClusterUpgradeRead(ctx, d, meta)...)
+}
+
+func resourceClusterUpgradeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	cluster, err := findClusterByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EKS Cluster (%s) not found, removing EKS Cluster Upgrade from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", d.Id(), err)
+	}
+
+	addons, err := findAddonsByClusterName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) Add-Ons: %s", d.Id(), err)
+	}
+
+	addonVersions := make(map[string]string, len(addons))
+	for _, addon := range addons {
+		addonVersions[aws.ToString(addon.AddonName)] = aws.ToString(addon.AddonVersion)
+	}
+
+	nodeGroups, err := findNodegroupsByClusterName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) Node Groups: %s", d.Id(), err)
+	}
+
+	nodeGroupVersions := make(map[string]string, len(nodeGroups))
+	for _, nodeGroup := range nodeGroups {
+		nodeGroupVersions[aws.ToString(nodeGroup.NodegroupName)] = aws.ToString(nodeGroup.Version)
+	}
+
+	d.Set("addon_versions", addonVersions)
+	d.Set(names.AttrClusterName, cluster.Name)
+	d.Set("node_group_versions", nodeGroupVersions)
+	d.Set(names.AttrVersion, cluster.Version)
+
+	return diags
+}
+
+func resourceClusterUpgradeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChanges("addon_versions", "node_group_versions", names.AttrVersion, "node_group_names", "upgrade_addons", "upgrade_node_groups") {
+		if err := upgradeCluster(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "upgrading EKS Cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterUpgradeRead(ctx, d, meta)...)
+}
+
+func resourceClusterUpgradeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// EKS clusters cannot be downgraded.
+	log.Printf("[DEBUG] Removing EKS Cluster Upgrade (%s) from state; the cluster version is unchanged", d.Id())
+
+	return diags
+}
+
+// resourceClusterUpgradeCustomizeDiff plans an update when add-ons or node groups are behind the configured version,
+// for example because an earlier upgrade failed after the control plane was upgraded, so that applying again resumes the upgrade.
+func resourceClusterUpgradeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange(names.AttrVersion) {
+		return nil
+	}
+
+	version := d.Get(names.AttrVersion).(string)
+
+	if d.Get("upgrade_node_groups").(bool) {
+		var nodeGroupNames []string
+		if v, ok := d.GetOk("node_group_names"); ok && v.(*schema.Set).Len() > 0 {
+			nodeGroupNames = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		for nodeGroupName, nodeGroupVersion := range d.Get("node_group_versions").(map[string]interface{}) {
+			if nodeGroupNames != nil && !slices.Contains(nodeGroupNames, nodeGroupName) {
+				continue
+			}
+
+			if nodeGroupVersion.(string) != version {
+				return d.SetNewComputed("node_group_versions")
+			}
+		}
+	}
+
+	if d.Get("upgrade_addons").(bool) {
+		conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+		for addonName, addonVersion := range d.Get("addon_versions").(map[string]interface{}) {
+			versions, err := findAddonVersionsByTwoPartKey(ctx, conn, addonName, version)
+
+			if err != nil {
+				return fmt.Errorf("reading EKS Add-On (%s) versions for Kubernetes version %s: %w", addonName, version, err)
+			}
+
+			if clusterUpgradeAddonVersion(addonVersion.(string), versions) != "" {
+				return d.SetNewComputed("addon_versions")
+			}
+		}
+	}
+
+	return nil
+}
+
+// upgradeCluster upgrades the cluster's control plane to the configured version one minor version at a time,
+// checking the cluster's upgrade readiness insights before each step, and then upgrades add-ons and managed node groups.
+// Each stage is skipped for components that are already up to date, so applying again after a failure resumes the upgrade.
+// The timeout covers the whole upgrade.
+func upgradeCluster(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+	deadline := tfresource.NewDeadline(timeout)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	version := d.Get(names.AttrVersion).(string)
+
+	cluster, err := findClusterByName(ctx, conn, clusterName)
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Cluster: %w", err)
+	}
+
+	steps, err := clusterUpgradeVersionSteps(aws.ToString(cluster.Version), version)
+
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		log.Printf("[INFO] Checking EKS Cluster (%s) upgrade readiness insights for version %s", clusterName, step)
+		if err := checkClusterUpgradeInsights(ctx, conn, clusterName, step, d.Get("stop_on_insight_warnings").(bool)); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Upgrading EKS Cluster (%s) control plane to version %s", clusterName, step)
+		input := &eks.UpdateClusterVersionInput{
+			Name:    aws.String(clusterName),
+			Version: aws.String(step),
+		}
+
+		output, err := conn.UpdateClusterVersion(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("updating control plane version to %s: %w", step, err)
+		}
+
+		updateID := aws.ToString(output.Update.Id)
+
+		if _, err := waitClusterUpdateSuccessful(ctx, conn, clusterName, updateID, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for control plane version update (%s) to %s: %w", updateID, step, err)
+		}
+	}
+
+	if d.Get("upgrade_addons").(bool) {
+		addons, err := findAddonsByClusterName(ctx, conn, clusterName)
+
+		if err != nil {
+			return fmt.Errorf("reading Add-Ons: %w", err)
+		}
+
+		for _, addon := range addons {
+			addonName := aws.ToString(addon.AddonName)
+			versions, err := findAddonVersionsByTwoPartKey(ctx, conn, addonName, version)
+
+			if err != nil {
+				return fmt.Errorf("reading Add-On (%s) versions for Kubernetes version %s: %w", addonName, version, err)
+			}
+
+			addonVersion := clusterUpgradeAddonVersion(aws.ToString(addon.AddonVersion), versions)
+			if addonVersion == "" {
+				continue
+			}
+
+			log.Printf("[INFO] Upgrading EKS Cluster (%s) Add-On (%s) from version %s to %s", clusterName, addonName, aws.ToString(addon.AddonVersion), addonVersion)
+			input := &eks.UpdateAddonInput{
+				AddonName:          aws.String(addonName),
+				AddonVersion:       aws.String(addonVersion),
+				ClientRequestToken: aws.String(id.UniqueId()),
+				ClusterName:        aws.String(clusterName),
+			}
+
+			if v, ok := d.GetOk("addon_resolve_conflicts"); ok {
+				input.ResolveConflicts = types.ResolveConflicts(v.(string))
+			}
+
+			output, err := conn.UpdateAddon(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("updating Add-On (%s) version to %s: %w", addonName, addonVersion, err)
+			}
+
+			updateID := aws.ToString(output.Update.Id)
+
+			if _, err := waitAddonUpdateSuccessful(ctx, conn, clusterName, addonName, updateID, deadline.Remaining()); err != nil {
+				return fmt.Errorf("waiting for Add-On (%s) version update (%s) to %s: %w", addonName, updateID, addonVersion, err)
+			}
+		}
+	}
+
+	if d.Get("upgrade_node_groups").(bool) {
+		nodeGroups, err := findNodegroupsByClusterName(ctx, conn, clusterName)
+
+		if err != nil {
+			return fmt.Errorf("reading Node Groups: %w", err)
+		}
+
+		var nodeGroupNames []string
+		if v, ok := d.GetOk("node_group_names"); ok && v.(*schema.Set).Len() > 0 {
+			nodeGroupNames = flex.ExpandStringValueSet(v.(*schema.Set))
+		}
+
+		for _, nodeGroup := range nodeGroups {
+			nodeGroupName := aws.ToString(nodeGroup.NodegroupName)
+
+			if nodeGroupNames != nil && !slices.Contains(nodeGroupNames, nodeGroupName) {
+				continue
+			}
+
+			if aws.ToString(nodeGroup.Version) == version {
+				continue
+			}
+
+			log.Printf("[INFO] Upgrading EKS Cluster (%s) Node Group (%s) from version %s to %s", clusterName, nodeGroupName, aws.ToString(nodeGroup.Version), version)
+			input := &eks.UpdateNodegroupVersionInput{
+				ClientRequestToken: aws.String(id.UniqueId()),
+				ClusterName:        aws.String(clusterName),
+				Force:              d.Get("force_node_group_update").(bool),
+				NodegroupName:      aws.String(nodeGroupName),
+				Version:            aws.String(version),
+			}
+
+			output, err := conn.UpdateNodegroupVersion(ctx, input)
+
+			if err != nil {
+				return fmt.Errorf("updating Node Group (%s) version to %s: %w", nodeGroupName, version, err)
+			}
+
+			updateID := aws.ToString(output.Update.Id)
+
+			if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, deadline.Remaining()); err != nil {
+				return fmt.Errorf("waiting for Node Group (%s) version update (%s) to %s: %w", nodeGroupName, updateID, version, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+var clusterUpgradeVersionRegexp = regexache.MustCompile(`^\d+\.\d+$`)
+
+// clusterUpgradeVersionSteps returns the Kubernetes minor versions that the control plane must be upgraded through,
+// in order, to go from the current version to the target version. EKS only supports upgrading one minor version at a time.
+func clusterUpgradeVersionSteps(current, target string) ([]string, error) {
+	parse := func(v string) (int, int, error) {
+		major, minor, ok := strings.Cut(v, ".")
+		if !ok {
+			return 0, 0, fmt.Errorf("invalid Kubernetes version: %s", v)
+		}
+
+		majorValue, err := strconv.Atoi(major)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid Kubernetes version: %s", v)
+		}
+
+		minorValue, err := strconv.Atoi(minor)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid Kubernetes version: %s", v)
+		}
+
+		return majorValue, minorValue, nil
+	}
+
+	currentMajor, currentMinor, err := parse(current)
+	if err != nil {
+		return nil, err
+	}
+
+	targetMajor, targetMinor, err := parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	if currentMajor != targetMajor {
+		return nil, fmt.Errorf("upgrading from Kubernetes version %s to %s is not supported", current, target)
+	}
+
+	if targetMinor < currentMinor {
+		return nil, fmt.Errorf("EKS Clusters cannot be downgraded from Kubernetes version %s to %s", current, target)
+	}
+
+	var steps []string
+	for minor := currentMinor + 1; minor <= targetMinor; minor++ {
+		steps = append(steps, fmt.Sprintf("%d.%d", targetMajor, minor))
+	}
+
+	return steps, nil
+}
+
+// clusterUpgradeAddonVersion returns the version to upgrade an add-on to, given its installed version and the add-on
+// versions compatible with the target Kubernetes version. That is the default version, if the installed version is
+// not compatible or is older than it. Otherwise it returns "", so that add-ons pinned to a newer version aren't downgraded.
+func clusterUpgradeAddonVersion(installedVersion string, versions []types.AddonVersionInfo) string {
+	var defaultVersion string
+	var compatible bool
+	for _, v := range versions {
+		addonVersion := aws.ToString(v.AddonVersion)
+
+		if addonVersion == installedVersion {
+			compatible = true
+		}
+
+		if slices.ContainsFunc(v.Compatibilities, func(c types.Compatibility) bool { return c.DefaultVersion }) {
+			defaultVersion = addonVersion
+		}
+	}
+
+	if defaultVersion == "" || defaultVersion == installedVersion {
+		return ""
+	}
+
+	if !compatible {
+		return defaultVersion
+	}
+
+	installed, err := gversion.NewVersion(installedVersion)
+	if err != nil {
+		return ""
+	}
+
+	target, err := gversion.NewVersion(defaultVersion)
+	if err != nil {
+		return ""
+	}
+
+	if installed.LessThan(target) {
+		return defaultVersion
+	}
+
+	return ""
+}
+
+// checkClusterUpgradeInsights returns an error describing any upgrade readiness insights for the specified
+// Kubernetes version that have an ERROR status, or a WARNING status if stopOnWarnings is set.
+func checkClusterUpgradeInsights(ctx context.Context, conn *eks.Client, clusterName, version string, stopOnWarnings bool) error {
+	statuses := []types.InsightStatusValue{types.InsightStatusValueError}
+	if stopOnWarnings {
+		statuses = append(statuses, types.InsightStatusValueWarning)
+	}
+
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(clusterName),
+		Filter: &types.InsightsFilter{
+			Categories:         []types.Category{types.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{version},
+			Statuses:           statuses,
+		},
+	}
+
+	insights, err := findInsights(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading upgrade readiness insights for version %s: %w", version, err)
+	}
+
+	var errs []error
+	for _, insight := range insights {
+		errs = append(errs, insightError(insight))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("upgrade readiness insights for version %s failed: %w", version, err)
+	}
+
+	return nil
+}
+
+func insightError(apiObject types.InsightSummary) error {
+	var status types.InsightStatusValue
+	var reason string
+	if v := apiObject.InsightStatus; v != nil {
+		status = v.Status
+		reason = aws.ToString(v.Reason)
+	}
+
+	return fmt.Errorf("%s: %s (%s): %s", status, aws.ToString(apiObject.Name), aws.ToString(apiObject.Id), reason)
+}
+
+func findInsights(ctx context.Context, conn *eks.Client, input *eks.ListInsightsInput) ([]types.InsightSummary, error) {
+	var output []types.InsightSummary
+
+	pages := eks.NewListInsightsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Insights...)
+	}
+
+	return output, nil
+}
+
+func findAddonVersionsByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, kubernetesVersion string) ([]types.AddonVersionInfo, error) {
+	input := &eks.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	}
+	var output []types.AddonVersionInfo
+
+	pages := eks.NewDescribeAddonVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Addons {
+			output = append(output, v.AddonVersions...)
+		}
+	}
+
+	return output, nil
+}
+
+func findAddonsByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]types.Addon, error) {
+	input := &eks.ListAddonsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var output []types.Addon
+
+	pages := eks.NewListAddonsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, addonName := range page.Addons {
+			addon, err := findAddonByTwoPartKey(ctx, conn, clusterName, addonName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *addon)
+		}
+	}
+
+	return output, nil
+}
+
+func findNodegroupsByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]types.Nodegroup, error) {
+	input := &eks.ListNodegroupsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var output []types.Nodegroup
+
+	pages := eks.NewListNodegroupsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, nodeGroupName := range page.Nodegroups {
+			nodeGroup, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *nodeGroup)
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestClusterUpgradeVersionSteps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current     string
+		target      string
+		expected    []string
+		expectError bool
+	}{
+		"same version": {
+			current: "1.29",
+			target:  "1.29",
+		},
+		"one minor version": {
+			current:  "1.29",
+			target:   "1.30",
+			expected: []string{"1.30"},
+		},
+		"multiple minor versions": {
+			current:  "1.27",
+			target:   "1.30",
+			expected: []string{"1.28", "1.29", "1.30"},
+		},
+		"downgrade": {
+			current:     "1.30",
+			target:      "1.29",
+			expectError: true,
+		},
+		"major version": {
+			current:     "1.30",
+			target:      "2.0",
+			expectError: true,
+		},
+		"invalid version": {
+			current:     "1.30",
+			target:      "latest",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfeks.ClusterUpgradeVersionSteps(testCase.current, testCase.target)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("got error %t, want %t: %v", got, want, err)
+			}
+
+			if !slices.Equal(got, testCase.expected) {
+				t.Errorf("got %v, want %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterUpgradeAddonVersion(t *testing.T) {
+	t.Parallel()
+
+	versions := []types.AddonVersionInfo{
+		{AddonVersion: aws.String("v1.18.3-eksbuild.1"), Compatibilities: []types.Compatibility{{ClusterVersion: aws.String("1.30")}}},
+		{AddonVersion: aws.String("v1.18.1-eksbuild.3"), Compatibilities: []types.Compatibility{{ClusterVersion: aws.String("1.30"), DefaultVersion: true}}},
+		{AddonVersion: aws.String("v1.18.1-eksbuild.1"), Compatibilities: []types.Compatibility{{ClusterVersion: aws.String("1.30")}}},
+	}
+
+	testCases := map[string]struct {
+		installedVersion string
+		versions         []types.AddonVersionInfo
+		expected         string
+	}{
+		"default": {
+			installedVersion: "v1.18.1-eksbuild.3",
+			versions:         versions,
+		},
+		"older compatible": {
+			installedVersion: "v1.18.1-eksbuild.1",
+			versions:         versions,
+			expected:         "v1.18.1-eksbuild.3",
+		},
+		"newer compatible": {
+			installedVersion: "v1.18.3-eksbuild.1",
+			versions:         versions,
+		},
+		"not compatible": {
+			installedVersion: "v1.15.1-eksbuild.1",
+			versions:         versions,
+			expected:         "v1.18.1-eksbuild.3",
+		},
+		"no default": {
+			installedVersion: "v1.15.1-eksbuild.1",
+			versions:         versions[:1],
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfeks.ClusterUpgradeAddonVersion(testCase.installedVersion, testCase.versions), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccEKSClusterUpgrade_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster_upgrade.test"
+	clusterResourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterUpgradeConfig_basic(rName, clusterVersionUpgradeInitial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, clusterResourceName, &cluster),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, clusterResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, clusterVersionUpgradeInitial),
+					resource.TestCheckResourceAttrSet(resourceName, "addon_versions.vpc-cni"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("node_group_versions.%s", rName), clusterVersionUpgradeInitial),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"addon_resolve_conflicts", "force_node_group_update", "stop_on_insight_warnings", "upgrade_addons", "upgrade_node_groups"},
+			},
+			{
+				Config: testAccClusterUpgradeConfig_basic(rName, clusterVersionUpgradeUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, clusterResourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, clusterVersionUpgradeUpdated),
+					resource.TestCheckResourceAttrSet(resourceName, "addon_versions.vpc-cni"),
+					resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("node_group_versions.%s", rName), clusterVersionUpgradeUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterUpgrade_downgrade(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterUpgradeConfig_basic(rName, clusterVersionUpgradeUpdated),
+			},
+			{
+				Config:      testAccClusterUpgradeConfig_basic(rName, clusterVersionUpgradeInitial),
+				ExpectError: regexache.MustCompile(`cannot be downgraded`),
+			},
+		},
+	})
+}
+
+func testAccClusterUpgradeConfig_basic(rName, version string) string {
+	return acctest.ConfigCompose(testAccNodeGroupBaseIAMAndVPCConfig(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.cluster.arn
+  version  = %[2]q
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  # The version is managed by aws_eks_cluster_upgrade.
+  lifecycle {
+    ignore_changes = [version]
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.cluster-AmazonEKSClusterPolicy,
+    aws_main_route_table_association.test,
+  ]
+}
+
+resource "aws_eks_addon" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  addon_name   = "vpc-cni"
+}
+
+resource "aws_eks_node_group" "test" {
+  cluster_name    = aws_eks_cluster.test.name
+  node_group_name = %[1]q
+  node_role_arn   = aws_iam_role.node.arn
+  subnet_ids      = aws_subnet.test[*].id
+
+  scaling_config {
+    desired_size = 1
+    max_size     = 1
+    min_size     = 1
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy,
+    aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy,
+    aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly,
+  ]
+}
+
+resource "aws_eks_cluster_upgrade" "test" {
+  cluster_name = aws_eks_cluster.test.name
+  version      = %[2]q
+
+  depends_on = [
+    aws_eks_addon.test,
+    aws_eks_node_group.test,
+  ]
+}
+`, rName, version))
+}
@@ -9,11 +9,14 @@ var (
 	ResourceAccessPolicyAssociation = resourceAccessPolicyAssociation
 	ResourceAddon                   = resourceAddon
 	ResourceCluster                 = resourceCluster
+	ResourceClusterUpgrade          = resourceClusterUpgrade
 	ResourceFargateProfile          = resourceFargateProfile
 	ResourceIdentityProviderConfig  = resourceIdentityProviderConfig
 	ResourceNodeGroup               = resourceNodeGroup
 	ResourcePodIdentityAssociation  = newPodIdentityAssociationResource
 
+	ClusterUpgradeAddonVersion                 = clusterUpgradeAddonVersion
+	ClusterUpgradeVersionSteps                 = clusterUpgradeVersionSteps
 	FindAccessEntryByTwoPartKey                = findAccessEntryByTwoPartKey
 	FindAccessPolicyAssociationByThreePartKey  = findAccessPolicyAssociationByThreePartKey
 	FindAddonByTwoPartKey                      = findAddonByTwoPartKey
@@ -99,6 +99,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceClusterUpgrade,
+			TypeName: "aws_eks_cluster_upgrade",
+			Name:     "Cluster Upgrade",
+		},
 		{
 			Factory:  resourceFargateProfile,
 			TypeName: "aws_eks_fargate_profile",
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Group Policy Attachments Exclusive")
+func newGroupPolicyAttachmentsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupPolicyAttachmentsExclusiveResource{}, nil
+}
+
+type groupPolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (*groupPolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_group_policy_attachments_exclusive"
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := syncGroupPolicyAttachments(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.GroupName
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.ID.ValueString()
+	policyARNs, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	// Any policy attached out-of-band shows up as a diff and is detached on the next apply.
+	data.PolicyARNs.SetValue = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, policyARNs)
+	data.GroupName = types.StringValue(groupName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := new.ID.ValueString()
+	if err := syncGroupPolicyAttachments(ctx, conn, groupName, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// syncGroupPolicyAttachments attaches the managed policies in want to the group and detaches all others.
+func syncGroupPolicyAttachments(ctx context.Context, conn *iam.Client, groupName string, want itypes.Set[string]) error {
+	have, err := findGroupAttachedPolicies(ctx, conn, groupName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Group (%s) policy attachments: %w", groupName, err)
+	}
+
+	var errsList []error
+
+	for _, policyARN := range want.Difference(have) {
+		if err := attachPolicyToGroup(ctx, conn, groupName, policyARN); err != nil {
+			errsList = append(errsList, err)
+		}
+	}
+
+	for _, policyARN := range itypes.Set[string](have).Difference(want) {
+		if err := detachPolicyFromGroup(ctx, conn, groupName, policyARN); err != nil {
+			errsList = append(errsList, err)
+		}
+	}
+
+	return errors.Join(errsList...)
+}
+
+func findGroupAttachedPolicies(ctx context.Context, conn *iam.Client, groupName string) ([]string, error) {
+	input := &iam.ListAttachedGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+
+	output, err := findAttachedGroupPolicies(ctx, conn, input, tfslices.PredicateTrue[awstypes.AttachedPolicy]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.AttachedPolicy) string {
+		return aws.ToString(v.PolicyArn)
+	}), nil
+}
+
+type groupPolicyAttachmentsExclusiveResourceModel struct {
+	ID         types.String                     `tfsdk:"id"`
+	PolicyARNs fwtypes.SetValueOf[types.String] `tfsdk:"policy_arns"`
+	GroupName  types.String                     `tfsdk:"group_name"`
+}
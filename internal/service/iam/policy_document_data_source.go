@@ -4,12 +4,15 @@
 package iam
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +25,24 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	policySizeLimitTargetInlineRole     = "inline_role"
+	policySizeLimitTargetInlineUser     = "inline_user"
+	policySizeLimitTargetManaged        = "managed"
+	policySizeLimitTargetResourcePolicy = "resource_policy"
+	policySizeLimitTargetSCP            = "scp"
+)
+
+// policySizeLimits are the maximum policy document sizes, in characters excluding whitespace, for each size limit target.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+var policySizeLimits = map[string]int{
+	policySizeLimitTargetInlineRole:     10240,
+	policySizeLimitTargetInlineUser:     2048,
+	policySizeLimitTargetManaged:        6144,
+	policySizeLimitTargetResourcePolicy: 20480,
+	policySizeLimitTargetSCP:            5120,
+}
+
 // @SDKDataSource("aws_iam_policy_document", name="Policy Document")
 func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
@@ -68,6 +89,10 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"minified_size": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
 				"override_json": {
 					Type:         schema.TypeString,
@@ -94,6 +119,17 @@ func dataSourcePolicyDocument() *schema.Resource {
 					ValidateFunc: validation.StringIsEmpty,
 					Deprecated:   "Not used",
 				},
+				"size_limit_target": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						policySizeLimitTargetInlineRole,
+						policySizeLimitTargetInlineUser,
+						policySizeLimitTargetManaged,
+						policySizeLimitTargetResourcePolicy,
+						policySizeLimitTargetSCP,
+					}, false),
+				},
 				"source_policy_documents": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -149,6 +185,18 @@ func dataSourcePolicyDocument() *schema.Resource {
 						},
 					},
 				},
+				"split": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					RequiredWith: []string{"size_limit_target"},
+				},
+				"split_json": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
 				names.AttrVersion: {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -323,6 +371,41 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("minified_json", jsonMinString)
 
+	minifiedSize, err := policyDocumentMinifiedSize(mergedDoc)
+	if err != nil {
+		// should never happen if the above code is correct
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+	}
+	d.Set("minified_size", minifiedSize)
+
+	var splitJSON []string
+
+	if v, ok := d.GetOk("size_limit_target"); ok {
+		target := v.(string)
+		limit := policySizeLimits[target]
+
+		if d.Get("split").(bool) {
+			docs, err := splitPolicyDocument(mergedDoc, limit)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: splitting for size_limit_target (%s): %s", target, err)
+			}
+
+			for _, doc := range docs {
+				jsonSplitDoc, err := policyDocumentMinifiedJSON(doc)
+				if err != nil {
+					// should never happen if the above code is correct
+					return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: formatting JSON: %s", err)
+				}
+
+				splitJSON = append(splitJSON, jsonSplitDoc)
+			}
+		} else if minifiedSize > limit {
+			diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document minified size (%d characters) exceeds the %s size limit (%d characters). Set split to true to generate split_json.", minifiedSize, target, limit)
+		}
+	}
+
+	d.Set("split_json", splitJSON)
+
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
@@ -392,3 +475,87 @@ func dataSourcePolicyDocumentMakePrincipals(in []interface{}, version string) (I
 	}
 	return IAMPolicyStatementPrincipalSet(out), nil
 }
+
+// splitPolicyDocument splits the statements of the specified policy document into consecutive runs,
+// each no larger than limit characters when minified. Statement order is preserved within and across the returned documents.
+func splitPolicyDocument(doc *IAMPolicyDoc, limit int) ([]*IAMPolicyDoc, error) {
+	newDoc := func(indices []int) *IAMPolicyDoc {
+		statements := make([]*IAMPolicyStatement, 0, len(indices))
+		for _, i := range indices {
+			statements = append(statements, doc.Statements[i])
+		}
+
+		return &IAMPolicyDoc{
+			Version:    doc.Version,
+			Id:         doc.Id,
+			Statements: statements,
+		}
+	}
+
+	size, err := policyDocumentMinifiedSize(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= limit {
+		return []*IAMPolicyDoc{doc}, nil
+	}
+
+	// Next-fit, so that statement order is preserved.
+	var bins [][]int
+	for i, stmt := range doc.Statements {
+		size, err := policyDocumentMinifiedSize(newDoc([]int{i}))
+		if err != nil {
+			return nil, err
+		}
+
+		if size > limit {
+			return nil, fmt.Errorf("statement %d (Sid %q) is %d characters on its own, which exceeds the size limit (%d characters)", i, stmt.Sid, size, limit)
+		}
+
+		if n := len(bins); n > 0 {
+			candidate := append(slices.Clone(bins[n-1]), i)
+			size, err := policyDocumentMinifiedSize(newDoc(candidate))
+			if err != nil {
+				return nil, err
+			}
+
+			if size <= limit {
+				bins[n-1] = candidate
+				continue
+			}
+		}
+
+		bins = append(bins, []int{i})
+	}
+
+	docs := make([]*IAMPolicyDoc, 0, len(bins))
+	for _, bin := range bins {
+		docs = append(docs, newDoc(bin))
+	}
+
+	return docs, nil
+}
+
+// policyDocumentMinifiedJSON returns the minified JSON of the specified policy document.
+// Unlike json.Marshal, '<', '>' and '&' are not escaped, so the size of the result matches what IAM counts.
+func policyDocumentMinifiedJSON(doc *IAMPolicyDoc) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(doc); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func policyDocumentMinifiedSize(doc *IAMPolicyDoc) (int, error) {
+	v, err := policyDocumentMinifiedJSON(doc)
+	if err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCountInString(v), nil
+}
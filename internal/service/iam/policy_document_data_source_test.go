@@ -4,12 +4,18 @@
 package iam_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -441,6 +447,178 @@ func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_sizeLimitTarget(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_sizeLimitTarget("managed", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_size", "6118"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_sizeLimitTarget("managed", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_size", "6118"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "split_json.0", dataSourceName, "minified_json"),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_sizeLimitTarget("inline_user", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_size", "6118"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_json.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestSplitPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	newStatement := func(i int, resources interface{}) *tfiam.IAMPolicyStatement {
+		return &tfiam.IAMPolicyStatement{
+			Sid:       fmt.Sprintf("Statement%03d", i),
+			Effect:    "Allow",
+			Actions:   []string{"s3:DeleteObject", "s3:GetObject", "s3:GetObjectVersion", "s3:ListBucket", "s3:PutObject"},
+			Resources: resources,
+		}
+	}
+	newDoc := func(n int) *tfiam.IAMPolicyDoc {
+		doc := &tfiam.IAMPolicyDoc{
+			Version: "2012-10-17",
+		}
+
+		for i := range n {
+			doc.Statements = append(doc.Statements, newStatement(i, "*"))
+		}
+
+		return doc
+	}
+	// Alternately large and small statements, which a size-sorting packer would reorder.
+	newMixedDoc := func(n int) *tfiam.IAMPolicyDoc {
+		doc := &tfiam.IAMPolicyDoc{
+			Version: "2012-10-17",
+		}
+
+		for i := range n {
+			resources := []string{"*"}
+			if i%2 == 0 {
+				for j := range 10 {
+					resources = append(resources, fmt.Sprintf("arn:aws:s3:::example-bucket-%02d/*", j))
+				}
+			}
+			doc.Statements = append(doc.Statements, newStatement(i, resources))
+		}
+
+		return doc
+	}
+	minifiedSize := func(doc *tfiam.IAMPolicyDoc) int {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+
+		if err := enc.Encode(doc); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return utf8.RuneCountInString(strings.TrimSuffix(buf.String(), "\n"))
+	}
+	// '<', '>' and '&' count as one character each, not as their \u00XX escapes.
+	htmlDoc := &tfiam.IAMPolicyDoc{
+		Version: "2012-10-17",
+		Statements: []*tfiam.IAMPolicyStatement{
+			newStatement(0, "arn:aws:s3:::example-bucket/<&>"),
+			newStatement(1, "arn:aws:s3:::example-bucket/<&>"),
+		},
+	}
+
+	testCases := map[string]struct {
+		doc          *tfiam.IAMPolicyDoc
+		limit        int
+		expectedDocs int
+		expectError  bool
+	}{
+		"fits": {
+			doc:          newDoc(40),
+			limit:        6144,
+			expectedDocs: 1,
+		},
+		"split": {
+			doc:          newDoc(40),
+			limit:        2048,
+			expectedDocs: 4,
+		},
+		"mixed sizes": {
+			doc:          newMixedDoc(20),
+			limit:        2048,
+			expectedDocs: 4,
+		},
+		"html characters": {
+			doc:          htmlDoc,
+			limit:        minifiedSize(htmlDoc),
+			expectedDocs: 1,
+		},
+		"statement too large": {
+			doc:         newDoc(1),
+			limit:       64,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			docs, err := tfiam.SplitPolicyDocument(testCase.doc, testCase.limit)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(docs), testCase.expectedDocs; got != want {
+				t.Fatalf("got %d documents, want %d", got, want)
+			}
+
+			var sids []string
+			for _, doc := range docs {
+				if got, limit := minifiedSize(doc), testCase.limit; got > limit {
+					t.Errorf("document is %d characters, exceeding limit of %d", got, limit)
+				}
+
+				for _, stmt := range doc.Statements {
+					sids = append(sids, stmt.Sid)
+				}
+			}
+
+			var expected []string
+			for _, stmt := range testCase.doc.Statements {
+				expected = append(expected, stmt.Sid)
+			}
+
+			if !slices.Equal(sids, expected) {
+				t.Errorf("got statements %v, want %v", sids, expected)
+			}
+		})
+	}
+}
+
 var testAccPolicyDocumentDataSourceConfig_basic = `
 data "aws_partition" "current" {}
 
@@ -1649,3 +1827,22 @@ data "aws_iam_policy_document" "partial" {
   }
 }
 `
+
+func testAccPolicyDocumentDataSourceConfig_sizeLimitTarget(target string, split bool) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  size_limit_target = %[1]q
+  split             = %[2]t
+
+  dynamic "statement" {
+    for_each = range(40)
+
+    content {
+      sid       = format("Statement%%03d", statement.value)
+      actions   = ["s3:DeleteObject", "s3:GetObject", "s3:GetObjectVersion", "s3:ListBucket", "s3:PutObject"]
+      resources = ["*"]
+    }
+  }
+}
+`, target, split)
+}
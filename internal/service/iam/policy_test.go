@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -349,6 +350,54 @@ func TestAccIAMPolicy_policyDuplicateKeys(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicy_policyValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var out awstypes.Policy
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyConfig_policyValidation(rName, `"iam:PassRole"`, "SECURITY_WARNING", false),
+				ExpectError: regexache.MustCompile(`SECURITY_WARNING finding PASS_ROLE_WITH_STAR_IN_RESOURCE`),
+			},
+			{
+				Config: testAccPolicyConfig_policyValidation(rName, `"iam:PassRole"`, "ERROR", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &out),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.0.check_no_new_access", "false"),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.0.severity_threshold", "ERROR"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_validation"},
+			},
+			{
+				// Changing only policy_validation must not publish a new policy version.
+				Config: testAccPolicyConfig_policyValidation(rName, `"iam:PassRole"`, "ERROR", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName, &out),
+					testAccCheckPolicyDefaultVersion(&out, "v1"),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.0.check_no_new_access", "true"),
+				),
+			},
+			{
+				Config:      testAccPolicyConfig_policyValidation(rName, `"iam:PassRole", "iam:CreateRole"`, "ERROR", true),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer no new access check`),
+			},
+		},
+	})
+}
+
 func testAccCheckPolicyExists(ctx context.Context, n string, v *awstypes.Policy) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -374,6 +423,16 @@ func testAccCheckPolicyExists(ctx context.Context, n string, v *awstypes.Policy)
 	}
 }
 
+func testAccCheckPolicyDefaultVersion(v *awstypes.Policy, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(v.DefaultVersionId); got != want {
+			return fmt.Errorf("IAM Policy default version = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -624,3 +683,25 @@ EOF
 }
 `, rName)
 }
+
+func testAccPolicyConfig_policyValidation(rName, actions, severityThreshold string, checkNoNewAccess bool) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = [%[2]s]
+      Resource = "*"
+    }]
+  })
+
+  policy_validation {
+    check_no_new_access = %[4]t
+    severity_threshold  = %[3]q
+  }
+}
+`, rName, actions, severityThreshold, checkNoNewAccess)
+}
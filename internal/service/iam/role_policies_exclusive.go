@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Role Policies Exclusive")
+func newRolePoliciesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &rolePoliciesExclusiveResource{}, nil
+}
+
+type rolePoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (*rolePoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role_policies_exclusive"
+}
+
+func (r *rolePoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := syncRoleInlinePolicies(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.RoleName
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.ID.ValueString()
+	policyNames, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// Any inline policy added out-of-band shows up as a diff and is removed on the next apply.
+	data.PolicyNames.SetValue = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, policyNames)
+	data.RoleName = types.StringValue(roleName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := new.ID.ValueString()
+	if err := syncRoleInlinePolicies(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// syncRoleInlinePolicies deletes any inline policy on the role that isn't in want.
+// Inline policies are created by aws_iam_role_policy, so missing policies are not added here.
+func syncRoleInlinePolicies(ctx context.Context, conn *iam.Client, roleName string, want itypes.Set[string]) error {
+	have, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s) inline policies: %w", roleName, err)
+	}
+
+	return deleteRoleInlinePolicies(ctx, conn, roleName, itypes.Set[string](have).Difference(want))
+}
+
+type rolePoliciesExclusiveResourceModel struct {
+	ID          types.String                     `tfsdk:"id"`
+	PolicyNames fwtypes.SetValueOf[types.String] `tfsdk:"policy_names"`
+	RoleName    types.String                     `tfsdk:"role_name"`
+}
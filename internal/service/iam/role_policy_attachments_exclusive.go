@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Role Policy Attachments Exclusive")
+func newRolePolicyAttachmentsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &rolePolicyAttachmentsExclusiveResource{}, nil
+}
+
+type rolePolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (*rolePolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role_policy_attachments_exclusive"
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := syncRolePolicyAttachments(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.RoleName
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.ID.ValueString()
+	policyARNs, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// Any policy attached out-of-band shows up as a diff and is detached on the next apply.
+	data.PolicyARNs.SetValue = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, policyARNs)
+	data.RoleName = types.StringValue(roleName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := new.ID.ValueString()
+	if err := syncRolePolicyAttachments(ctx, conn, roleName, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// syncRolePolicyAttachments attaches the managed policies in want to the role and detaches all others.
+func syncRolePolicyAttachments(ctx context.Context, conn *iam.Client, roleName string, want itypes.Set[string]) error {
+	have, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading IAM Role (%s) policy attachments: %w", roleName, err)
+	}
+
+	var errsList []error
+
+	for _, policyARN := range want.Difference(have) {
+		if err := attachPolicyToRole(ctx, conn, roleName, policyARN); err != nil {
+			errsList = append(errsList, err)
+		}
+	}
+
+	for _, policyARN := range itypes.Set[string](have).Difference(want) {
+		if err := detachPolicyFromRole(ctx, conn, roleName, policyARN); err != nil {
+			errsList = append(errsList, err)
+		}
+	}
+
+	return errors.Join(errsList...)
+}
+
+type rolePolicyAttachmentsExclusiveResourceModel struct {
+	ID         types.String                     `tfsdk:"id"`
+	PolicyARNs fwtypes.SetValueOf[types.String] `tfsdk:"policy_arns"`
+	RoleName   types.String                     `tfsdk:"role_name"`
+}
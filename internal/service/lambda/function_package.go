@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a ZIP file uploaded directly in a CreateFunction or UpdateFunctionCode request.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	functionZipFileMaxSize = 50 * 1024 * 1024
+)
+
+var (
+	// All entries get the earliest time representable in the ZIP format so that builds are reproducible.
+	functionZipModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// functionSourcePackage is the deployment package built from source_dir.
+type functionSourcePackage struct {
+	s3Bucket string
+	s3Key    string
+	zipFile  []byte
+}
+
+// packageFunctionSourceDir builds the deployment package for source_dir.
+// Packages too large to upload directly are staged in source_staging_bucket.
+func packageFunctionSourceDir(ctx context.Context, d *schema.ResourceData, meta interface{}) (*functionSourcePackage, error) {
+	sourceDir := d.Get("source_dir").(string)
+	zipFile, err := buildFunctionZip(sourceDir, flex.ExpandStringValueSet(d.Get("source_excludes").(*schema.Set)))
+
+	if err != nil {
+		return nil, fmt.Errorf("building ZIP file from source_dir (%s): %w", sourceDir, err)
+	}
+
+	if len(zipFile) <= functionZipFileMaxSize {
+		return &functionSourcePackage{
+			zipFile: zipFile,
+		}, nil
+	}
+
+	bucket, ok := d.GetOk("source_staging_bucket")
+	if !ok {
+		return nil, fmt.Errorf("ZIP file built from source_dir (%s) is %d bytes, larger than the %d byte direct upload limit; set source_staging_bucket", sourceDir, len(zipFile), functionZipFileMaxSize)
+	}
+
+	sum := sha256.Sum256(zipFile)
+	key := fmt.Sprintf("%s/%s.zip", d.Get("function_name").(string), hex.EncodeToString(sum[:]))
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	_, err = conn.PutObject(ctx, &s3.PutObjectInput{
+		Body:   bytes.NewReader(zipFile),
+		Bucket: aws.String(bucket.(string)),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading ZIP file to S3 Bucket (%s): %w", bucket, err)
+	}
+
+	return &functionSourcePackage{
+		s3Bucket: bucket.(string),
+		s3Key:    key,
+	}, nil
+}
+
+// setSourceCodeHashFromSourceDir sets source_code_hash to the hash Lambda will report for the package built from source_dir,
+// so that plans only show a code change when the directory's contents change.
+func setSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("source_excludes") {
+		return d.SetNewComputed("source_code_hash")
+	}
+
+	sourceDir, ok := d.GetOk("source_dir")
+	if !ok {
+		return nil
+	}
+
+	zipFile, err := buildFunctionZip(sourceDir.(string), flex.ExpandStringValueSet(d.Get("source_excludes").(*schema.Set)))
+
+	if err != nil {
+		return fmt.Errorf("building ZIP file from source_dir (%s): %w", sourceDir, err)
+	}
+
+	if hash := functionCodeSHA256(zipFile); hash != d.Get("source_code_hash").(string) {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
+
+// buildFunctionZip returns a reproducible ZIP file of the regular files in dir.
+// Entries are sorted by path and have fixed timestamps and permissions, so identical trees produce identical bytes on any machine.
+func buildFunctionZip(dir string, excludes []string) ([]byte, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range excludes {
+		if _, err := filepath.Match(filepath.FromSlash(pattern), ""); err != nil {
+			return nil, fmt.Errorf("invalid source_excludes pattern (%s): %w", pattern, err)
+		}
+	}
+
+	type entry struct {
+		name string
+		path string
+		mode fs.FileMode
+	}
+	var entries []entry
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if p == dir {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		if slices.ContainsFunc(excludes, func(pattern string) bool { return matchSourceExclude(pattern, rel) }) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		// Only the executable bit is kept; umask and ownership differences are discarded.
+		mode := fs.FileMode(0644)
+		if info.Mode()&0111 != 0 {
+			mode = 0755
+		}
+
+		entries = append(entries, entry{
+			name: filepath.ToSlash(rel),
+			path: p,
+			mode: mode,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no files found in %s", dir)
+	}
+
+	slices.SortFunc(entries, func(a, b entry) int {
+		return strings.Compare(a.name, b.name)
+	})
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, e := range entries {
+		header := &zip.FileHeader{
+			Name:     e.name,
+			Method:   zip.Deflate,
+			Modified: functionZipModified,
+		}
+		header.SetMode(e.mode)
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := copyFileTo(fw, e.path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func copyFileTo(w io.Writer, p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(w, f)
+
+	return err
+}
+
+// matchSourceExclude reports whether the path relative to source_dir matches the slash-separated pattern.
+// Patterns without a '/' are also matched against the base name, so "*.pyc" excludes files in any directory.
+func matchSourceExclude(pattern, rel string) bool {
+	if ok, _ := filepath.Match(filepath.FromSlash(pattern), rel); ok {
+		return true
+	}
+
+	if !strings.Contains(pattern, "/") {
+		ok, _ := filepath.Match(pattern, filepath.Base(rel))
+		return ok
+	}
+
+	return false
+}
+
+// functionCodeSHA256 returns the package hash in the format of the CodeSha256 reported by Lambda.
+func functionCodeSHA256(zipFile []byte) string {
+	sum := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
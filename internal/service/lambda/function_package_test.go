@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestBuildFunctionZip(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"index.js":          "exports.handler = async () => {};",
+		"lib/util.js":       "module.exports = {};",
+		"lib/util.test.js":  "test",
+		"node_modules/a.js": "a",
+		"README.md":         "readme",
+	}
+	excludes := []string{"*.md", "*.test.js"}
+
+	dir1, dir2 := t.TempDir(), t.TempDir()
+	for _, dir := range []string{dir1, dir2} {
+		for name, content := range files {
+			p := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	// Differing timestamps and permissions must not change the output.
+	if err := os.Chtimes(filepath.Join(dir2, "index.js"), time.Now(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(filepath.Join(dir2, "lib", "util.js"), 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	zip1, err := buildFunctionZip(dir1, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zip2, err := buildFunctionZip(dir2, excludes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(zip1, zip2) {
+		t.Errorf("expected identical ZIP files, got hashes %s and %s", functionCodeSHA256(zip1), functionCodeSHA256(zip2))
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(zip1), int64(len(zip1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+
+		if got, want := f.Mode().Perm(), os.FileMode(0644); got != want {
+			t.Errorf("%s: mode = %s, want %s", f.Name, got, want)
+		}
+	}
+
+	if want := []string{"index.js", "lib/util.js", "node_modules/a.js"}; !slices.Equal(names, want) {
+		t.Errorf("entries = %v, want %v", names, want)
+	}
+}
+
+func TestBuildFunctionZip_executable(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "bootstrap"), []byte("#!/bin/sh"), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := buildFunctionZip(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := r.File[0].Mode().Perm(), os.FileMode(0755); got != want {
+		t.Errorf("mode = %s, want %s", got, want)
+	}
+}
+
+func TestBuildFunctionZip_empty(t *testing.T) {
+	t.Parallel()
+
+	if _, err := buildFunctionZip(t.TempDir(), nil); err == nil {
+		t.Error("expected error for empty directory")
+	}
+}
@@ -90,6 +90,72 @@ func TestAccLambdaFunction_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_sourceDir(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionOutput
+	resourceName := "aws_lambda_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := t.TempDir()
+	var hash string
+
+	writeSource := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(sourceDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("index.js", `exports.handler = async () => "v1";`)
+	writeSource("README.md", "excluded")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "source_dir", sourceDir),
+					resource.TestCheckResourceAttr(resourceName, "source_excludes.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "source_code_hash"),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(v string) error {
+						hash = v
+						return nil
+					}),
+				),
+			},
+			{
+				// Touching files without changing their contents doesn't produce a diff.
+				PreConfig: func() {
+					now := time.Now()
+					if err := os.Chtimes(filepath.Join(sourceDir, "index.js"), now, now); err != nil {
+						t.Fatal(err)
+					}
+					writeSource("README.md", "still excluded")
+				},
+				Config:   testAccFunctionConfig_sourceDir(rName, sourceDir),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					writeSource("index.js", `exports.handler = async () => "v2";`)
+				},
+				Config: testAccFunctionConfig_sourceDir(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttrWith(resourceName, "source_code_hash", func(v string) error {
+						if v == hash {
+							return fmt.Errorf("expected source_code_hash to change")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var function lambda.GetFunctionOutput
@@ -2794,6 +2860,21 @@ resource "aws_lambda_function" "test" {
 `, rName))
 }
 
+func testAccFunctionConfig_sourceDir(rName, sourceDir string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  source_dir      = %[2]q
+  source_excludes = ["*.md"]
+  function_name   = %[1]q
+  role            = aws_iam_role.iam_for_lambda.arn
+  handler         = "index.handler"
+  runtime         = "nodejs20.x"
+}
+`, rName, sourceDir))
+}
+
 func testAccFunctionConfig_basicConcurrency(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
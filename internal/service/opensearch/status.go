@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -19,8 +20,8 @@ const (
 	ConfigStatusExists   = "Exists"
 )
 
-func statusUpgradeStatus(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func statusUpgradeStatus(ctx context.Context, conn *opensearchservice.OpenSearchService, name string) tfresource.StatusFunc[opensearchservice.GetUpgradeStatusOutput] {
+	return func() (*opensearchservice.GetUpgradeStatusOutput, string, error) {
 		out, err := conn.GetUpgradeStatusWithContext(ctx, &opensearchservice.GetUpgradeStatusInput{
 			DomainName: aws.String(name),
 		})
@@ -21,22 +21,17 @@ const (
 
 // UpgradeSucceeded waits for an Upgrade to return Success
 func waitUpgradeSucceeded(ctx context.Context, conn *opensearchservice.OpenSearchService, name string, timeout time.Duration) (*opensearchservice.GetUpgradeStatusOutput, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending:    []string{opensearchservice.UpgradeStatusInProgress},
-		Target:     []string{opensearchservice.UpgradeStatusSucceeded},
-		Refresh:    statusUpgradeStatus(ctx, conn, name),
-		Timeout:    timeout,
-		MinTimeout: domainUpgradeSuccessMinTimeout,
-		Delay:      domainUpgradeSuccessDelay,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*opensearchservice.GetUpgradeStatusOutput); ok {
-		return output, err
+	stateWaiter := &tfresource.StateWaiter[opensearchservice.GetUpgradeStatusOutput]{
+		Pending:     []string{opensearchservice.UpgradeStatusInProgress},
+		Target:      []string{opensearchservice.UpgradeStatusSucceeded},
+		Refresh:     statusUpgradeStatus(ctx, conn, name),
+		Timeout:     timeout,
+		MinTimeout:  domainUpgradeSuccessMinTimeout,
+		Delay:       domainUpgradeSuccessDelay,
+		Description: fmt.Sprintf("OpenSearch Domain (%s) upgrade", name),
 	}
 
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func WaitForDomainCreation(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName string, timeout time.Duration) error {
@@ -0,0 +1,689 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newPluginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type pluginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*pluginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_plugin"
+}
+
+func (r *pluginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	secretAuthConfigurationBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[secretAuthConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrRoleARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+					"secret_arn": schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"build_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginBuildStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id":  framework.IDAttribute(),
+			"server_url": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration":               secretAuthConfigurationBlock(),
+						"oauth2_client_credential_configuration": secretAuthConfigurationBlock(),
+					},
+					Validators: []validator.Object{
+						objectvalidator.ExactlyOneOf(
+							path.MatchRelative().AtName("basic_auth_configuration"),
+							path.MatchRelative().AtName("oauth2_client_credential_configuration"),
+						),
+					},
+				},
+			},
+			"custom_plugin_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[customPluginConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_schema_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.APISchemaType](),
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"api_schema": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"payload": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+								Validators: []validator.Object{
+									objectvalidator.ExactlyOneOf(
+										path.MatchRelative().AtName("payload"),
+										path.MatchRelative().AtName("s3"),
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreatePluginInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		ClientToken:   aws.String(id.UniqueId()),
+		DisplayName:   fwflex.StringFromFramework(ctx, data.DisplayName),
+		ServerUrl:     fwflex.StringFromFramework(ctx, data.ServerURL),
+		Tags:          getTagsIn(ctx),
+		Type:          data.Type.ValueEnum(),
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	input.AuthConfiguration = expandPluginAuthConfiguration(ctx, data.AuthConfiguration)
+	input.CustomPluginConfiguration = expandCustomPluginConfiguration(ctx, data.CustomPluginConfiguration)
+
+	output, err := conn.CreatePlugin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Plugin (%s)", data.DisplayName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PluginID = fwflex.StringToFramework(ctx, output.PluginId)
+	data.setID()
+
+	plugin, err := waitPluginCreated(ctx, conn, data.PluginID.ValueString(), data.ApplicationID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The plugin state can only be set on update.
+	if state := data.State.ValueEnum(); state != "" && state != plugin.State {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+			PluginId:      fwflex.StringFromFramework(ctx, data.PluginID),
+			State:         state,
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		plugin, err = waitPluginUpdated(ctx, conn, data.PluginID.ValueString(), data.ApplicationID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	data.BuildStatus = fwtypes.StringEnumValue(plugin.BuildStatus)
+	data.PluginARN = fwflex.StringToFramework(ctx, plugin.PluginArn)
+	data.State = fwtypes.StringEnumValue(plugin.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findPluginByTwoPartKey(ctx, conn, data.PluginID.ValueString(), data.ApplicationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.BuildStatus = fwtypes.StringEnumValue(output.BuildStatus)
+	data.DisplayName = fwflex.StringToFramework(ctx, output.DisplayName)
+	data.PluginARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.ServerURL = fwflex.StringToFramework(ctx, output.ServerUrl)
+	data.State = fwtypes.StringEnumValue(output.State)
+	data.Type = fwtypes.StringEnumValue(output.Type)
+
+	// AutoFlEx doesn't yet handle union types.
+	data.AuthConfiguration = flattenPluginAuthConfiguration(ctx, output.AuthConfiguration)
+	data.CustomPluginConfiguration = flattenCustomPluginConfiguration(ctx, output.CustomPluginConfiguration)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.AuthConfiguration.Equal(old.AuthConfiguration) ||
+		!new.CustomPluginConfiguration.Equal(old.CustomPluginConfiguration) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.ServerURL.Equal(old.ServerURL) ||
+		!new.State.Equal(old.State) {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: fwflex.StringFromFramework(ctx, new.ApplicationID),
+			DisplayName:   fwflex.StringFromFramework(ctx, new.DisplayName),
+			PluginId:      fwflex.StringFromFramework(ctx, new.PluginID),
+			ServerUrl:     fwflex.StringFromFramework(ctx, new.ServerURL),
+			State:         new.State.ValueEnum(),
+		}
+
+		// AutoFlEx doesn't yet handle union types.
+		input.AuthConfiguration = expandPluginAuthConfiguration(ctx, new.AuthConfiguration)
+		input.CustomPluginConfiguration = expandCustomPluginConfiguration(ctx, new.CustomPluginConfiguration)
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		plugin, err := waitPluginUpdated(ctx, conn, new.PluginID.ValueString(), new.ApplicationID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.BuildStatus = fwtypes.StringEnumValue(plugin.BuildStatus)
+		new.State = fwtypes.StringEnumValue(plugin.State)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeletePlugin(ctx, &qbusiness.DeletePluginInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		PluginId:      fwflex.StringFromFramework(ctx, data.PluginID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, data.PluginID.ValueString(), data.ApplicationID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pluginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, pluginID, applicationID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPlugin(ctx context.Context, conn *qbusiness.Client, pluginID, applicationID string) tfresource.StatusFunc[qbusiness.GetPluginOutput] {
+	return func() (*qbusiness.GetPluginOutput, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, pluginID, applicationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginCreated(ctx context.Context, conn *qbusiness.Client, pluginID, applicationID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateWaiter := &tfresource.StateWaiter[qbusiness.GetPluginOutput]{
+		Pending:     enum.Slice(awstypes.PluginBuildStatusCreateInProgress),
+		Target:      enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh:     statusPlugin(ctx, conn, pluginID, applicationID),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("Amazon Q Business Plugin (%s)", pluginID),
+	}
+
+	return stateWaiter.WaitForState(ctx)
+}
+
+func waitPluginUpdated(ctx context.Context, conn *qbusiness.Client, pluginID, applicationID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateWaiter := &tfresource.StateWaiter[qbusiness.GetPluginOutput]{
+		Pending:     enum.Slice(awstypes.PluginBuildStatusUpdateInProgress),
+		Target:      enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh:     statusPlugin(ctx, conn, pluginID, applicationID),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("Amazon Q Business Plugin (%s)", pluginID),
+	}
+
+	return stateWaiter.WaitForState(ctx)
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, pluginID, applicationID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateWaiter := &tfresource.StateWaiter[qbusiness.GetPluginOutput]{
+		Pending:     enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:      []string{},
+		Refresh:     statusPlugin(ctx, conn, pluginID, applicationID),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("Amazon Q Business Plugin (%s)", pluginID),
+	}
+
+	return stateWaiter.WaitForState(ctx)
+}
+
+func expandPluginAuthConfiguration(ctx context.Context, v fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]) awstypes.PluginAuthConfiguration {
+	pluginAuthConfigurationData := fwdiag.Must(v.ToPtr(ctx))
+
+	if pluginAuthConfigurationData == nil {
+		return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}
+	}
+
+	if !pluginAuthConfigurationData.BasicAuthConfiguration.IsNull() {
+		secretAuthConfigurationData := fwdiag.Must(pluginAuthConfigurationData.BasicAuthConfiguration.ToPtr(ctx))
+
+		return &awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration{
+			Value: awstypes.BasicAuthConfiguration{
+				RoleArn:   fwflex.StringFromFramework(ctx, secretAuthConfigurationData.RoleARN),
+				SecretArn: fwflex.StringFromFramework(ctx, secretAuthConfigurationData.SecretARN),
+			},
+		}
+	}
+
+	if !pluginAuthConfigurationData.OAuth2ClientCredentialConfiguration.IsNull() {
+		secretAuthConfigurationData := fwdiag.Must(pluginAuthConfigurationData.OAuth2ClientCredentialConfiguration.ToPtr(ctx))
+
+		return &awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration{
+			Value: awstypes.OAuth2ClientCredentialConfiguration{
+				RoleArn:   fwflex.StringFromFramework(ctx, secretAuthConfigurationData.RoleARN),
+				SecretArn: fwflex.StringFromFramework(ctx, secretAuthConfigurationData.SecretARN),
+			},
+		}
+	}
+
+	return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}
+}
+
+func flattenPluginAuthConfiguration(ctx context.Context, apiObject awstypes.PluginAuthConfiguration) fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel] {
+	pluginAuthConfigurationData := pluginAuthConfigurationModel{
+		BasicAuthConfiguration:              fwtypes.NewListNestedObjectValueOfNull[secretAuthConfigurationModel](ctx),
+		OAuth2ClientCredentialConfiguration: fwtypes.NewListNestedObjectValueOfNull[secretAuthConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration:
+		pluginAuthConfigurationData.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &secretAuthConfigurationModel{
+			RoleARN:   fwtypes.ARNValue(aws.ToString(v.Value.RoleArn)),
+			SecretARN: fwtypes.ARNValue(aws.ToString(v.Value.SecretArn)),
+		})
+
+	case *awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration:
+		pluginAuthConfigurationData.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &secretAuthConfigurationModel{
+			RoleARN:   fwtypes.ARNValue(aws.ToString(v.Value.RoleArn)),
+			SecretARN: fwtypes.ARNValue(aws.ToString(v.Value.SecretArn)),
+		})
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[pluginAuthConfigurationModel](ctx)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &pluginAuthConfigurationData)
+}
+
+func expandCustomPluginConfiguration(ctx context.Context, v fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel]) *awstypes.CustomPluginConfiguration {
+	customPluginConfigurationData := fwdiag.Must(v.ToPtr(ctx))
+
+	if customPluginConfigurationData == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.CustomPluginConfiguration{
+		ApiSchemaType: customPluginConfigurationData.APISchemaType.ValueEnum(),
+		Description:   fwflex.StringFromFramework(ctx, customPluginConfigurationData.Description),
+	}
+
+	if apiSchemaData := fwdiag.Must(customPluginConfigurationData.APISchema.ToPtr(ctx)); apiSchemaData != nil {
+		if !apiSchemaData.Payload.IsNull() {
+			apiObject.ApiSchema = &awstypes.APISchemaMemberPayload{
+				Value: apiSchemaData.Payload.ValueString(),
+			}
+		}
+
+		if s3Data := fwdiag.Must(apiSchemaData.S3.ToPtr(ctx)); s3Data != nil {
+			apiObject.ApiSchema = &awstypes.APISchemaMemberS3{
+				Value: awstypes.S3{
+					Bucket: fwflex.StringFromFramework(ctx, s3Data.Bucket),
+					Key:    fwflex.StringFromFramework(ctx, s3Data.Key),
+				},
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenCustomPluginConfiguration(ctx context.Context, apiObject *awstypes.CustomPluginConfiguration) fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[customPluginConfigurationModel](ctx)
+	}
+
+	customPluginConfigurationData := customPluginConfigurationModel{
+		APISchema:     fwtypes.NewListNestedObjectValueOfNull[apiSchemaModel](ctx),
+		APISchemaType: fwtypes.StringEnumValue(apiObject.ApiSchemaType),
+		Description:   fwflex.StringToFramework(ctx, apiObject.Description),
+	}
+
+	apiSchemaData := apiSchemaModel{
+		Payload: types.StringNull(),
+		S3:      fwtypes.NewListNestedObjectValueOfNull[s3Model](ctx),
+	}
+
+	switch v := apiObject.ApiSchema.(type) {
+	case *awstypes.APISchemaMemberPayload:
+		apiSchemaData.Payload = fwflex.StringValueToFramework(ctx, v.Value)
+		customPluginConfigurationData.APISchema = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &apiSchemaData)
+
+	case *awstypes.APISchemaMemberS3:
+		apiSchemaData.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3Model{
+			Bucket: fwflex.StringToFramework(ctx, v.Value.Bucket),
+			Key:    fwflex.StringToFramework(ctx, v.Value.Key),
+		})
+		customPluginConfigurationData.APISchema = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &apiSchemaData)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &customPluginConfigurationData)
+}
+
+type pluginResourceModel struct {
+	ApplicationID             types.String                                                    `tfsdk:"application_id"`
+	AuthConfiguration         fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]   `tfsdk:"auth_configuration"`
+	BuildStatus               fwtypes.StringEnum[awstypes.PluginBuildStatus]                  `tfsdk:"build_status"`
+	CustomPluginConfiguration fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] `tfsdk:"custom_plugin_configuration"`
+	DisplayName               types.String                                                    `tfsdk:"display_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	PluginARN                 types.String                                                    `tfsdk:"arn"`
+	PluginID                  types.String                                                    `tfsdk:"plugin_id"`
+	ServerURL                 types.String                                                    `tfsdk:"server_url"`
+	State                     fwtypes.StringEnum[awstypes.PluginState]                        `tfsdk:"state"`
+	Tags                      types.Map                                                       `tfsdk:"tags"`
+	TagsAll                   types.Map                                                       `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type                      fwtypes.StringEnum[awstypes.PluginType]                         `tfsdk:"type"`
+}
+
+const (
+	pluginResourceIDPartCount = 2
+)
+
+func (m *pluginResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, pluginResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.PluginID = types.StringValue(parts[0])
+	m.ApplicationID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *pluginResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.PluginID.ValueString(), m.ApplicationID.ValueString()}, pluginResourceIDPartCount, false)))
+}
+
+type pluginAuthConfigurationModel struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[secretAuthConfigurationModel] `tfsdk:"basic_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[secretAuthConfigurationModel] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+type secretAuthConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type customPluginConfigurationModel struct {
+	APISchema     fwtypes.ListNestedObjectValueOf[apiSchemaModel] `tfsdk:"api_schema"`
+	APISchemaType fwtypes.StringEnum[awstypes.APISchemaType]      `tfsdk:"api_schema_type"`
+	Description   types.String                                    `tfsdk:"description"`
+}
+
+type apiSchemaModel struct {
+	Payload types.String                             `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3Model] `tfsdk:"s3"`
+}
+
+type s3Model struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
@@ -12,6 +12,8 @@ import (
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -62,10 +64,13 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration, optFns ...func(*rds_sdkv2.SwitchoverBlueGreenDeploymentInput)) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	for _, fn := range optFns {
+		fn(input)
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -162,3 +167,105 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds.RDS
+}
+
+func newClusterHandler(conn *rds.RDS) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds_sdkv2.CreateBlueGreenDeploymentInput {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok && d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// deleteSource deletes the old Blue cluster, which was renamed during switchover, and its instances.
+func (h *clusterHandler) deleteSource(ctx context.Context, arn string, timeout time.Duration) error {
+	cluster, err := FindDBClusterByID(ctx, h.conn, arn)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	clusterID := aws.StringValue(cluster.DBClusterIdentifier)
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(v.DBInstanceIdentifier)
+		_, err := h.conn.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting RDS DB Instance (%s): %w", instanceID, err)
+		}
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(v.DBInstanceIdentifier)
+		if _, err := waitDBInstanceDeleted(ctx, h.conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", instanceID, err)
+		}
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		_, err := h.conn.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(clusterID),
+			DeletionProtection:  aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling deletion protection: %w", err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, h.conn, clusterID, timeout); err != nil {
+			return fmt.Errorf("disabling deletion protection: waiting for completion: %w", err)
+		}
+	}
+
+	_, err = h.conn.DeleteDBClusterWithContext(ctx, &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(clusterID),
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %w", clusterID, err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, h.conn, clusterID, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) delete: %w", clusterID, err)
+	}
+
+	return nil
+}
@@ -5,12 +5,15 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -104,6 +107,24 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
+					},
+				},
+			},
 			names.AttrClusterIdentifier: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -567,6 +588,25 @@ func ResourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				if engine := d.Get("engine").(string); !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+				if engineMode := d.Get("engine_mode").(string); engineMode != EngineModeProvisioned {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine_mode" is %q.`, engineMode)
+				}
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -1288,15 +1328,32 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	if d.HasChangesExcept(
+	// Engine version and parameter group changes are applied to a Green environment which then replaces the cluster.
+	blueGreenApplied := false
+	if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(clusterBlueGreenUpdateAttributes()...) {
+		if err := clusterBlueGreenUpdate(ctx, d, meta); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+
+		blueGreenApplied = true
+	}
+
+	except := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"delete_automated_backups",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		names.AttrTags, names.AttrTagsAll) {
+		names.AttrTags, names.AttrTagsAll,
+	}
+	if blueGreenApplied {
+		except = append(except, clusterBlueGreenUpdateAttributes()...)
+	}
+
+	if d.HasChangesExcept(except...) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get(names.AttrApplyImmediately).(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -1326,7 +1383,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreenApplied {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1335,7 +1392,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); (ok || d.HasChange("db_instance_parameter_group_name")) && !blueGreenApplied {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
@@ -1371,14 +1428,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange(names.AttrEngineVersion) {
+		if d.HasChange(names.AttrEngineVersion) && !blueGreenApplied {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
 		// This can happen when updates are deferred (apply_immediately = false), and
 		// multiple applies occur before the maintenance window. In this case,
 		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) && !blueGreenApplied {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
@@ -1528,6 +1585,82 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+// clusterBlueGreenUpdate creates a Blue/Green Deployment with the new engine version and parameter groups,
+// switches over to the Green environment and then deletes the old Blue cluster and its instances.
+// After switchover the Green cluster has the original identifier, so the resource ID is unchanged.
+func clusterBlueGreenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (err error) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+	connV2 := meta.(*conns.AWSClient).RDSClient(ctx)
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+	orchestrator := newBlueGreenOrchestrator(connV2)
+	defer orchestrator.CleanUp(ctx)
+
+	handler := newClusterHandler(conn)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+
+	dep, err := orchestrator.CreateDeployment(ctx, handler.createBlueGreenInput(d))
+	if err != nil {
+		return err
+	}
+
+	deploymentIdentifier := aws.StringValue(dep.BlueGreenDeploymentIdentifier)
+	switchedOver := false
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+		// Ensure that the Blue/Green Deployment is always cleaned up, along with the Green environment if switchover didn't happen.
+		input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: aws.String(deploymentIdentifier),
+		}
+		if !switchedOver {
+			input.DeleteTarget = aws.Bool(true)
+		}
+		if _, deleteErr := connV2.DeleteBlueGreenDeployment(ctx, input); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("deleting Blue/Green Deployment: %w", deleteErr))
+			return
+		}
+
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds_sdkv2.Client, optFns ...tfresource.OptionsFunc) {
+			if _, waitErr := waitBlueGreenDeploymentDeleted(ctx, conn, deploymentIdentifier, deadline.Remaining(), optFns...); waitErr != nil {
+				err = errors.Join(err, fmt.Errorf("deleting Blue/Green Deployment: waiting for completion: %w", waitErr))
+			}
+		})
+	}()
+
+	// The deployment is available once the Green environment has been created and is replicating from Blue.
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return err
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, conn, aws.StringValue(dep.Target), deadline.Remaining()); err != nil {
+		return fmt.Errorf("creating Blue/Green Deployment: waiting for Green environment: %w", err)
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+	dep, err = orchestrator.Switchover(ctx, deploymentIdentifier, deadline.Remaining(), func(input *rds_sdkv2.SwitchoverBlueGreenDeploymentInput) {
+		if v, ok := d.GetOk("blue_green_update.0.switchover_timeout"); ok {
+			input.SwitchoverTimeout = aws.Int32(int32(v.(int)))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	switchedOver = true
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+
+	if err := handler.deleteSource(ctx, aws.StringValue(dep.Source), deadline.Remaining()); err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment source: %w", err)
+	}
+
+	return nil
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
@@ -1678,6 +1811,21 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *r
 	compareActualEngineVersion(d, oldVersion, newVersion, pendingVersion)
 }
 
+func clusterBlueGreenUpdateAttributes() []string {
+	return []string{
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+		names.AttrEngineVersion,
+	}
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
 func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -1745,8 +1893,8 @@ func findDBClusters(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBClu
 	return output, nil
 }
 
-func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) tfresource.StatusFunc[rds.DBCluster] {
+	return func() (*rds.DBCluster, string, error) {
 		output, err := FindDBClusterByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
@@ -1762,7 +1910,7 @@ func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) retry.StateR
 }
 
 func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBCluster]{
 		Pending: []string{
 			ClusterStatusBackingUp,
 			ClusterStatusCreating,
@@ -1772,24 +1920,19 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout
 			ClusterStatusRebooting,
 			ClusterStatusResettingMasterCredentials,
 		},
-		Target:     []string{ClusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:      []string{ClusterStatusAvailable},
+		Refresh:     statusDBCluster(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster (%s)", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBCluster); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBCluster]{
 		Pending: []string{
 			ClusterStatusBackingUp,
 			ClusterStatusConfiguringIAMDatabaseAuth,
@@ -1799,24 +1942,19 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout
 			ClusterStatusScalingCompute,
 			ClusterStatusUpgrading,
 		},
-		Target:     []string{ClusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:      []string{ClusterStatusAvailable},
+		Refresh:     statusDBCluster(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster (%s)", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBCluster); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBCluster]{
 		Pending: []string{
 			ClusterStatusAvailable,
 			ClusterStatusBackingUp,
@@ -1825,18 +1963,13 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout
 			ClusterStatusPromoting,
 			ClusterStatusScalingCompute,
 		},
-		Target:     []string{},
-		Refresh:    statusDBCluster(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBCluster); ok {
-		return output, err
+		Target:      []string{},
+		Refresh:     statusDBCluster(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster (%s)", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
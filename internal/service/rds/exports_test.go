@@ -6,12 +6,14 @@ package rds
 // Exports for use in tests only.
 var (
 	ResourceEventSubscription       = resourceEventSubscription
+	ResourceIntegration             = newIntegrationResource
 	ResourceProxy                   = resourceProxy
 	ResourceProxyDefaultTargetGroup = resourceProxyDefaultTargetGroup
 	ResourceProxyEndpoint           = resourceProxyEndpoint
 	ResourceProxyTarget             = resourceProxyTarget
 	ResourceSubnetGroup             = resourceSubnetGroup
 
+	DBInstanceState                            = dbInstanceState
 	FindDBInstanceByID                         = findDBInstanceByIDSDKv1
 	FindDBProxyByName                          = findDBProxyByName
 	FindDBProxyEndpointByTwoPartKey            = findDBProxyEndpointByTwoPartKey
@@ -19,6 +21,7 @@ var (
 	FindDBSubnetGroupByName                    = findDBSubnetGroupByName
 	FindDefaultDBProxyTargetGroupByDBProxyName = findDefaultDBProxyTargetGroupByDBProxyName
 	FindEventSubscriptionByID                  = findEventSubscriptionByID
+	FindIntegrationByARN                       = findIntegrationByARN
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParseDBInstanceARN                         = parseDBInstanceARN
@@ -2714,8 +2714,8 @@ func findDBInstanceByIDSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id str
 	return tfresource.AssertSingleValueResult(output.DBInstances)
 }
 
-func statusDBInstanceSDKv1(ctx context.Context, conn *rds.RDS, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func statusDBInstanceSDKv1(ctx context.Context, conn *rds.RDS, id string) tfresource.StatusFunc[rds.DBInstance] {
+	return func() (*rds.DBInstance, string, error) {
 		output, err := findDBInstanceByIDSDKv1(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
@@ -2730,8 +2730,8 @@ func statusDBInstanceSDKv1(ctx context.Context, conn *rds.RDS, id string) retry.
 	}
 }
 
-func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) retry.StateRefreshFunc {
-	return func() (interface{}, string, error) {
+func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string) tfresource.StatusFunc[types.DBInstance] {
+	return func() (*types.DBInstance, string, error) {
 		output, err := findDBInstanceByIDSDKv2(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
@@ -2756,7 +2756,7 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBInstance]{
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -2774,22 +2774,17 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 			InstanceStatusStorageFull,
 			InstanceStatusUpgrading,
 		},
-		Target:  []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh: statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout: timeout,
+		Target:      []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:     statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS DB Instance (%s)", id),
 	}
-	options.Apply(stateConf)
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	stateWaiter.Apply(options)
 
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
-func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
+func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
 		Delay:                     1 * time.Minute,
@@ -2799,7 +2794,7 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[types.DBInstance]{
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -2817,19 +2812,14 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 			InstanceStatusStorageFull,
 			InstanceStatusUpgrading,
 		},
-		Target:  []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh: statusDBInstanceSDKv2(ctx, conn, id),
-		Timeout: timeout,
-	}
-	options.Apply(stateConf)
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
+		Target:      []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:     statusDBInstanceSDKv2(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS DB Instance (%s)", id),
 	}
+	stateWaiter.Apply(options)
 
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
@@ -2842,7 +2832,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeou
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBInstance]{
 		Pending: []string{
 			InstanceStatusAvailable,
 			InstanceStatusBackingUp,
@@ -2859,19 +2849,14 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeou
 			InstanceStatusStorageFull,
 			InstanceStatusStorageOptimization,
 		},
-		Target:  []string{},
-		Refresh: statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout: timeout,
+		Target:      []string{},
+		Refresh:     statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS DB Instance (%s)", id),
 	}
-	options.Apply(stateConf)
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	stateWaiter.Apply(options)
 
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func findBlueGreenDeploymentByID(ctx context.Context, conn *rds_sdkv2.Client, id string) (*types.BlueGreenDeployment, error) {
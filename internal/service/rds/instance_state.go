@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Instance State")
+func newInstanceStateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &instanceStateResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type instanceStateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*instanceStateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rds_instance_state"
+}
+
+func (r *instanceStateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIdentifier: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(InstanceStatusAvailable, InstanceStatusStopped),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *instanceStateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data instanceStateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	id := data.Identifier.ValueString()
+	if err := updateDBInstanceState(ctx, conn, id, data.State.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating RDS Instance State (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = data.Identifier
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *instanceStateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data instanceStateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	output, err := findDBInstanceByIDSDKv2(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Instance State (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// RDS automatically starts an instance that has been stopped for seven days.
+	// Reporting the actual status means the next apply stops it again.
+	data.Identifier = fwflex.StringToFramework(ctx, output.DBInstanceIdentifier)
+	data.State = types.StringValue(dbInstanceState(aws.ToString(output.DBInstanceStatus)))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *instanceStateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new instanceStateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.State.Equal(old.State) {
+		if err := updateDBInstanceState(ctx, conn, new.ID.ValueString(), new.State.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating RDS Instance State (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// updateDBInstanceState waits for any in-progress operation on the DB instance to finish
+// and then starts or stops the instance so that it ends up in the configured state.
+func updateDBInstanceState(ctx context.Context, conn *rds.Client, id, configuredState string, timeout time.Duration) error {
+	instance, err := waitDBInstanceStable(ctx, conn, id, timeout)
+
+	if err != nil {
+		return fmt.Errorf("waiting for RDS DB Instance (%s) stable: %w", id, err)
+	}
+
+	if dbInstanceState(aws.ToString(instance.DBInstanceStatus)) == configuredState {
+		return nil
+	}
+
+	switch configuredState {
+	case InstanceStatusAvailable:
+		if _, err := conn.StartDBInstance(ctx, &rds.StartDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		}); err != nil {
+			return fmt.Errorf("starting RDS DB Instance (%s): %w", id, err)
+		}
+
+		if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) start: %w", id, err)
+		}
+
+	case InstanceStatusStopped:
+		if _, err := conn.StopDBInstance(ctx, &rds.StopDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		}); err != nil {
+			return fmt.Errorf("stopping RDS DB Instance (%s): %w", id, err)
+		}
+
+		if _, err := waitDBInstanceStopped(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) stop: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// dbInstanceState returns the configurable state corresponding to a DB instance status.
+// An instance is usable during storage optimization, which can take several hours, so it is treated as available.
+func dbInstanceState(status string) string {
+	if status == InstanceStatusStorageOptimization {
+		return InstanceStatusAvailable
+	}
+
+	return status
+}
+
+func waitDBInstanceStable(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.DBInstance, error) {
+	stateWaiter := &tfresource.StateWaiter[awstypes.DBInstance]{
+		Pending: []string{
+			InstanceStatusBackingUp,
+			InstanceStatusConfiguringEnhancedMonitoring,
+			InstanceStatusConfiguringIAMDatabaseAuth,
+			InstanceStatusConfiguringLogExports,
+			InstanceStatusCreating,
+			InstanceStatusMaintenance,
+			InstanceStatusModifying,
+			InstanceStatusMovingToVPC,
+			InstanceStatusRebooting,
+			InstanceStatusRenaming,
+			InstanceStatusResettingMasterCredentials,
+			InstanceStatusStarting,
+			InstanceStatusStopping,
+			InstanceStatusStorageFull,
+			InstanceStatusUpgrading,
+		},
+		Target:       []string{InstanceStatusAvailable, InstanceStatusStopped, InstanceStatusStorageOptimization},
+		Refresh:      statusDBInstanceSDKv2(ctx, conn, id),
+		Timeout:      timeout,
+		Description:  fmt.Sprintf("RDS DB Instance (%s)", id),
+		PollInterval: 10 * time.Second,
+	}
+
+	return stateWaiter.WaitForState(ctx)
+}
+
+func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*awstypes.DBInstance, error) {
+	stateWaiter := &tfresource.StateWaiter[awstypes.DBInstance]{
+		Pending:      []string{InstanceStatusAvailable, InstanceStatusStopping},
+		Target:       []string{InstanceStatusStopped},
+		Refresh:      statusDBInstanceSDKv2(ctx, conn, id),
+		Timeout:      timeout,
+		Description:  fmt.Sprintf("RDS DB Instance (%s)", id),
+		PollInterval: 10 * time.Second,
+	}
+
+	return stateWaiter.WaitForState(ctx)
+}
+
+type instanceStateResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	Identifier types.String   `tfsdk:"identifier"`
+	State      types.String   `tfsdk:"state"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDBInstanceState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"available":            "available",
+		"backing-up":           "backing-up",
+		"stopped":              "stopped",
+		"storage-optimization": "available",
+	}
+
+	for status, expected := range testCases {
+		t.Run(status, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfrds.DBInstanceState(status); got != expected {
+				t.Errorf("got %q, want %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestAccRDSInstanceState_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_instance_state.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceStateConfig_basic(rName, "stopped"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "aws_db_instance.test", names.AttrIdentifier),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIdentifier, "aws_db_instance.test", names.AttrIdentifier),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "stopped"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccInstanceStateConfig_basic(rName, "available"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, "available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInstanceStateConfig_basic(rName, state string) string {
+	return acctest.ConfigCompose(testAccInstanceConfig_basic(rName), fmt.Sprintf(`
+resource "aws_rds_instance_state" "test" {
+  identifier = aws_db_instance.test.identifier
+  state      = %[1]q
+}
+`, state))
+}
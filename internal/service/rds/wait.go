@@ -5,10 +5,12 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func waitDBClusterRoleAssociationCreated(ctx context.Context, conn *rds.RDS, dbClusterID, roleARN string, timeout time.Duration) (*rds.DBClusterRole, error) {
@@ -50,7 +52,7 @@ func waitDBClusterRoleAssociationDeleted(ctx context.Context, conn *rds.RDS, dbC
 }
 
 func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBInstance]{
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -66,24 +68,19 @@ func waitDBClusterInstanceCreated(ctx context.Context, conn *rds.RDS, id string,
 			InstanceStatusStorageOptimization,
 			InstanceStatusUpgrading,
 		},
-		Target:     []string{InstanceStatusAvailable},
-		Refresh:    statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
+		Target:      []string{InstanceStatusAvailable},
+		Refresh:     statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster Instance (%s)", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitDBClusterInstanceUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBInstance]{
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -99,44 +96,34 @@ func waitDBClusterInstanceUpdated(ctx context.Context, conn *rds.RDS, id string,
 			InstanceStatusStorageOptimization,
 			InstanceStatusUpgrading,
 		},
-		Target:     []string{InstanceStatusAvailable},
-		Refresh:    statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:      []string{InstanceStatusAvailable},
+		Refresh:     statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster Instance (%s)", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitDBClusterInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBInstance, error) {
-	stateConf := &retry.StateChangeConf{
+	stateWaiter := &tfresource.StateWaiter[rds.DBInstance]{
 		Pending: []string{
 			InstanceStatusConfiguringLogExports,
 			InstanceStatusDeletePreCheck,
 			InstanceStatusDeleting,
 			InstanceStatusModifying,
 		},
-		Target:     []string{},
-		Refresh:    statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:      []string{},
+		Refresh:     statusDBInstanceSDKv1(ctx, conn, id),
+		Timeout:     timeout,
+		Description: fmt.Sprintf("RDS Cluster Instance (%s)", id),
+		MinTimeout:  10 * time.Second,
+		Delay:       30 * time.Second,
 	}
 
-	outputRaw, err := stateConf.WaitForStateContext(ctx)
-
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
-		return output, err
-	}
-
-	return nil, err
+	return stateWaiter.WaitForState(ctx)
 }
 
 func waitReservedInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
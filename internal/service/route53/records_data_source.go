@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recordRoutingPolicyCIDR         = "cidr"
+	recordRoutingPolicyFailover     = "failover"
+	recordRoutingPolicyGeolocation  = "geolocation"
+	recordRoutingPolicyGeoproximity = "geoproximity"
+	recordRoutingPolicyLatency      = "latency"
+	recordRoutingPolicyMultivalue   = "multivalue"
+	recordRoutingPolicySimple       = "simple"
+	recordRoutingPolicyWeighted     = "weighted"
+)
+
+func recordRoutingPolicy_Values() []string {
+	return []string{
+		recordRoutingPolicyCIDR,
+		recordRoutingPolicyFailover,
+		recordRoutingPolicyGeolocation,
+		recordRoutingPolicyGeoproximity,
+		recordRoutingPolicyLatency,
+		recordRoutingPolicyMultivalue,
+		recordRoutingPolicySimple,
+		recordRoutingPolicyWeighted,
+	}
+}
+
+// @SDKDataSource("aws_route53_records", name="Records")
+func dataSourceRecords() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRecordsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"resource_record_sets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAlias: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"geoproximity_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"aws_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"bias": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"coordinates": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"latitude": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"longitude": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"local_zone_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"import_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"routing_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrWeight: {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"routing_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(recordRoutingPolicy_Values(), false),
+			},
+			names.AttrType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RRType](),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Client(ctx)
+
+	zoneID := cleanZoneID(d.Get("zone_id").(string))
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	filter := tfslices.PredicateTrue[*awstypes.ResourceRecordSet]()
+
+	if v, ok := d.GetOk("name_regex"); ok {
+		re := regexache.MustCompile(v.(string))
+		filter = tfslices.PredicateAnd(filter, func(v *awstypes.ResourceRecordSet) bool {
+			return re.MatchString(normalizeAliasName(aws.ToString(v.Name)))
+		})
+	}
+
+	if v, ok := d.GetOk("routing_policy"); ok {
+		routingPolicy := v.(string)
+		filter = tfslices.PredicateAnd(filter, func(v *awstypes.ResourceRecordSet) bool {
+			return resourceRecordSetRoutingPolicy(v) == routingPolicy
+		})
+	}
+
+	if v, ok := d.GetOk(names.AttrType); ok {
+		rrType := awstypes.RRType(v.(string))
+		filter = tfslices.PredicateAnd(filter, func(v *awstypes.ResourceRecordSet) bool {
+			return v.Type == rrType
+		})
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), filter)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", zoneID, err)
+	}
+
+	d.SetId(zoneID)
+	if err := d.Set("resource_record_sets", flattenResourceRecordSets(zoneID, output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_record_sets: %s", err)
+	}
+
+	return diags
+}
+
+// resourceRecordSetRoutingPolicy returns the routing policy implied by the fields set on a record set.
+func resourceRecordSetRoutingPolicy(apiObject *awstypes.ResourceRecordSet) string {
+	switch {
+	case apiObject.CidrRoutingConfig != nil:
+		return recordRoutingPolicyCIDR
+	case apiObject.Failover != "":
+		return recordRoutingPolicyFailover
+	case apiObject.GeoLocation != nil:
+		return recordRoutingPolicyGeolocation
+	case apiObject.GeoProximityLocation != nil:
+		return recordRoutingPolicyGeoproximity
+	case apiObject.Region != "":
+		return recordRoutingPolicyLatency
+	case aws.ToBool(apiObject.MultiValueAnswer):
+		return recordRoutingPolicyMultivalue
+	case apiObject.Weight != nil:
+		return recordRoutingPolicyWeighted
+	default:
+		return recordRoutingPolicySimple
+	}
+}
+
+func flattenResourceRecordSets(zoneID string, apiObjects []awstypes.ResourceRecordSet) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenResourceRecordSet(zoneID, &apiObject))
+	}
+
+	return tfList
+}
+
+func flattenResourceRecordSet(zoneID string, apiObject *awstypes.ResourceRecordSet) map[string]interface{} {
+	name := normalizeAliasName(aws.ToString(apiObject.Name))
+	tfMap := map[string]interface{}{
+		"health_check_id":                  aws.ToString(apiObject.HealthCheckId),
+		"multivalue_answer_routing_policy": aws.ToBool(apiObject.MultiValueAnswer),
+		names.AttrName:                     name,
+		"records":                          flattenResourceRecords(apiObject.ResourceRecords, apiObject.Type),
+		"routing_policy":                   resourceRecordSetRoutingPolicy(apiObject),
+		"set_identifier":                   aws.ToString(apiObject.SetIdentifier),
+		"ttl":                              aws.ToInt64(apiObject.TTL),
+		names.AttrType:                     apiObject.Type,
+	}
+
+	// The ID format accepted by aws_route53_record import.
+	parts := []string{zoneID, name, string(apiObject.Type)}
+	if v := aws.ToString(apiObject.SetIdentifier); v != "" {
+		parts = append(parts, v)
+	}
+	tfMap["import_id"] = strings.Join(parts, "_")
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap[names.AttrAlias] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": v.EvaluateTargetHealth,
+			names.AttrName:           normalizeAliasName(aws.ToString(v.DNSName)),
+			"zone_id":                aws.ToString(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+			"collection_id": aws.ToString(v.CollectionId),
+			"location_name": aws.ToString(v.LocationName),
+		}}
+	}
+
+	if v := apiObject.Failover; v != "" {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			names.AttrType: v,
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.ToString(v.ContinentCode),
+			"country":     aws.ToString(v.CountryCode),
+			"subdivision": aws.ToString(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.GeoProximityLocation; v != nil {
+		tfMap["geoproximity_routing_policy"] = []interface{}{map[string]interface{}{
+			"aws_region":       aws.ToString(v.AWSRegion),
+			"bias":             aws.ToInt32(v.Bias),
+			"coordinates":      flattenCoordinate(v.Coordinates),
+			"local_zone_group": aws.ToString(v.LocalZoneGroup),
+		}}
+	}
+
+	if v := apiObject.Region; v != "" {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			names.AttrRegion: v,
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			names.AttrWeight: aws.ToInt64(v),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// NS, SOA and the two A records.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	resourceName := "aws_route53_record.weighted"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_filter(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_record_sets.0.import_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_record_sets.0.name", resourceName, "fqdn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.0", "127.0.0.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.routing_policy", "weighted"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.set_identifier", "weighted"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.weighted_routing_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.weighted_routing_policy.0.weight", "10"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_base(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "simple" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "simple.%[1]s"
+  type    = "A"
+  ttl     = 30
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_record" "weighted" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "weighted.%[1]s"
+  type           = "A"
+  ttl            = 30
+  records        = ["127.0.0.2"]
+  set_identifier = "weighted"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [aws_route53_record.simple, aws_route53_record.weighted]
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_filter(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsDataSourceConfig_base(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name_regex     = "^weighted\\."
+  type           = "A"
+  routing_policy = "weighted"
+
+  depends_on = [aws_route53_record.simple, aws_route53_record.weighted]
+}
+`)
+}
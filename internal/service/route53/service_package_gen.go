@@ -34,6 +34,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_route53_delegation_set",
 			Name:     "Reusable Delegation Set",
 		},
+		{
+			Factory:  dataSourceRecords,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+		},
 		{
 			Factory:  dataSourceTrafficPolicyDocument,
 			TypeName: "aws_route53_traffic_policy_document",
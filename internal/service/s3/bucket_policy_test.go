@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -451,6 +452,43 @@ func TestAccS3BucketPolicy_directoryBucket(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketPolicy_policyValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketPolicyConfig_policyValidationNoPrincipal(rName),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer policy validation: ERROR finding`),
+			},
+			{
+				Config: testAccBucketPolicyConfig_policyValidation(rName, "s3:GetObject", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.0.severity_threshold", "ERROR"),
+				),
+			},
+			{
+				Config: testAccBucketPolicyConfig_policyValidation(rName, "s3:GetObject", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy_validation.0.check_no_new_access", "true"),
+				),
+			},
+			{
+				Config:      testAccBucketPolicyConfig_policyValidation(rName, "s3:*", true),
+				ExpectError: regexache.MustCompile(`IAM Access Analyzer no new access check`),
+			},
+		},
+	})
+}
+
 func testAccCheckBucketPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		for _, rs := range s.RootModule().Resources {
@@ -977,3 +1015,59 @@ data "aws_iam_policy_document" "test" {
 }
 `)
 }
+
+func testAccBucketPolicyConfig_policyValidationBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+`, rName)
+}
+
+func testAccBucketPolicyConfig_policyValidation(rName, action string, checkNoNewAccess bool) string {
+	return acctest.ConfigCompose(testAccBucketPolicyConfig_policyValidationBase(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+      Action   = %[1]q
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+
+  policy_validation {
+    check_no_new_access = %[2]t
+  }
+}
+`, action, checkNoNewAccess))
+}
+
+func testAccBucketPolicyConfig_policyValidationNoPrincipal(rName string) string {
+	return acctest.ConfigCompose(testAccBucketPolicyConfig_policyValidationBase(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  # The bucket ARN is known at plan time so that the policy can be validated.
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:${data.aws_partition.current.partition}:s3:::%[1]s/*"
+    }]
+  })
+
+  policy_validation {}
+}
+`, rName))
+}
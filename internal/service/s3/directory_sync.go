@@ -0,0 +1,607 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @FrameworkResource(name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (*directorySyncResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_sync"
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"delete_removed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"exclude": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cache_control": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[cacheControlRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, data.Bucket.ValueString())
+
+	id := data.id()
+	files, err := data.localFiles(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	if err := syncDirectory(ctx, conn, &data, files, nil, true, optFns...); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Files = fwflex.FlattenFrameworkStringValueMap(ctx, files.hashes())
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, data.Bucket.ValueString())
+
+	keys, err := findObjectKeysByPrefix(ctx, conn, data.Bucket.ValueString(), data.KeyPrefix.ValueString(), optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		response.Diagnostics.AddWarning(
+			"Resource not found",
+			fmt.Sprintf("S3 Bucket (%s) not found, removing S3 Directory Sync (%s) from state", data.Bucket.ValueString(), data.ID.ValueString()),
+		)
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Objects deleted out-of-band are dropped from state so that they are uploaded again on the next apply.
+	remote := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		remote[key] = struct{}{}
+	}
+	files := fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)
+	for key := range files {
+		if _, ok := remote[key]; !ok {
+			delete(files, key)
+		}
+	}
+	data.Files = fwflex.FlattenFrameworkStringValueMap(ctx, files)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, new.Bucket.ValueString())
+
+	id := new.ID.ValueString()
+	files, err := new.localFiles(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	// A change to the Cache-Control rules means every object's metadata may be stale.
+	uploadAll := !new.CacheControl.Equal(old.CacheControl)
+	if err := syncDirectory(ctx, conn, &new, files, fwflex.ExpandFrameworkStringValueMap(ctx, old.Files), uploadAll, optFns...); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	new.Files = fwflex.FlattenFrameworkStringValueMap(ctx, files.hashes())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn, optFns := r.conn(ctx, data.Bucket.ValueString())
+
+	keys := tfmaps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Files))
+	err := deleteObjectsByKey(ctx, conn, data.Bucket.ValueString(), keys, optFns...)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// ModifyPlan hashes the contents of source_dir so that local changes show up as a diff on files.
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.SourceDir.IsUnknown() || data.Include.IsUnknown() || data.Exclude.IsUnknown() || data.CacheControl.IsUnknown() {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), types.MapUnknown(types.StringType))...)
+
+		return
+	}
+
+	files, err := data.localFiles(ctx)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading S3 Directory Sync source directory", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), fwflex.FlattenFrameworkStringValueMap(ctx, files.hashes()))...)
+}
+
+func (r *directorySyncResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.DeleteRemoved.IsUnknown() || data.KeyPrefix.IsUnknown() || !data.DeleteRemoved.ValueBool() {
+		return
+	}
+
+	// Without a trailing '/', the prefix "site" would also match objects such as "site-backup/index.html",
+	// and an empty prefix matches every object in the bucket.
+	if !strings.HasSuffix(data.KeyPrefix.ValueString(), "/") {
+		response.Diagnostics.AddAttributeError(
+			path.Root("key_prefix"),
+			"Invalid Attribute Configuration",
+			"key_prefix must end in '/' when delete_removed is true",
+		)
+	}
+}
+
+func (r *directorySyncResource) conn(ctx context.Context, bucket string) (*s3.Client, []func(*s3.Options)) {
+	conn := r.Meta().S3Client(ctx)
+	var optFns []func(*s3.Options)
+
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+	// Via S3 access point: "Invalid configuration: region from ARN `us-east-1` does not match client region `aws-global` and UseArnRegion is `false`".
+	if arn.IsARN(bucket) && conn.Options().Region == names.GlobalRegionID {
+		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
+	}
+
+	return conn, optFns
+}
+
+// syncDirectory uploads every local file whose hash differs from that in have (or all files if uploadAll is set),
+// deletes the previously uploaded objects in have whose local file has been removed or excluded and, if configured,
+// deletes any other object under the key prefix that is not in the local directory.
+func syncDirectory(ctx context.Context, conn *s3.Client, data *directorySyncResourceModel, files localDirectoryFiles, have map[string]string, uploadAll bool, optFns ...func(*s3.Options)) error {
+	bucket := data.Bucket.ValueString()
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+
+	for key, file := range files {
+		if !uploadAll && have[key] == file.hash {
+			continue
+		}
+
+		if err := uploadDirectoryFile(ctx, uploader, bucket, key, file); err != nil {
+			return err
+		}
+	}
+
+	// Objects uploaded by this resource are no longer tracked once dropped from files, so always delete them.
+	var removed []string
+	for key := range have {
+		if _, ok := files[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+
+	if err := deleteObjectsByKey(ctx, conn, bucket, removed, optFns...); err != nil {
+		return err
+	}
+
+	if !data.DeleteRemoved.ValueBool() {
+		return nil
+	}
+
+	keys, err := findObjectKeysByPrefix(ctx, conn, bucket, data.KeyPrefix.ValueString(), optFns...)
+
+	if err != nil {
+		return fmt.Errorf("listing S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	keys = slices.DeleteFunc(keys, func(key string) bool {
+		_, ok := files[key]
+		return ok
+	})
+
+	return deleteObjectsByKey(ctx, conn, bucket, keys, optFns...)
+}
+
+func uploadDirectoryFile(ctx context.Context, uploader *manager.Uploader, bucket, key string, file localDirectoryFile) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+	}
+	defer func() {
+		err := f.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", file.path, err)
+		}
+	}()
+
+	input := &s3.PutObjectInput{
+		Body:   f,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	if file.cacheControl != "" {
+		input.CacheControl = aws.String(file.cacheControl)
+	}
+
+	if file.contentType != "" {
+		input.ContentType = aws.String(file.contentType)
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s/%s): %w", bucket, key, err)
+	}
+
+	return nil
+}
+
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string, optFns ...func(*s3.Options)) ([]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	var keys []string
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			keys = append(keys, aws.ToString(v.Key))
+		}
+	}
+
+	return keys, nil
+}
+
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string, optFns ...func(*s3.Options)) error {
+	// DeleteObjects accepts at most 1000 keys per request.
+	const (
+		batchSize = 1000
+	)
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(keys, batchSize) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &awstypes.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(v string) awstypes.ObjectIdentifier {
+					return awstypes.ObjectIdentifier{
+						Key: aws.String(v),
+					}
+				}),
+				Quiet: aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input, optFns...)
+
+		if err != nil {
+			return err
+		}
+
+		for _, v := range output.Errors {
+			errs = append(errs, newDeleteObjectVersionError(v))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+	}
+
+	return nil
+}
+
+type localDirectoryFile struct {
+	cacheControl string
+	contentType  string
+	hash         string
+	path         string
+}
+
+// localDirectoryFiles is keyed by S3 object key.
+type localDirectoryFiles map[string]localDirectoryFile
+
+func (files localDirectoryFiles) hashes() map[string]string {
+	hashes := make(map[string]string, len(files))
+
+	for key, file := range files {
+		hashes[key] = file.hash
+	}
+
+	return hashes
+}
+
+// localFiles walks source_dir and returns the files selected by include and exclude.
+func (data *directorySyncResourceModel) localFiles(ctx context.Context) (localDirectoryFiles, error) {
+	sourceDir, err := homedir.Expand(data.SourceDir.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source_dir (%s): %w", data.SourceDir.ValueString(), err)
+	}
+
+	includes := fwflex.ExpandFrameworkStringValueSet(ctx, data.Include)
+	excludes := fwflex.ExpandFrameworkStringValueSet(ctx, data.Exclude)
+	var cacheControlRules []cacheControlRuleModel
+	if diags := data.CacheControl.ElementsAs(ctx, &cacheControlRules, false); diags.HasError() {
+		return nil, errors.New("reading cache_control rules")
+	}
+
+	for _, pattern := range slices.Concat(includes, excludes, tfslices.ApplyToAll(cacheControlRules, func(v cacheControlRuleModel) string {
+		return v.Pattern.ValueString()
+	})) {
+		if _, err := filepath.Match(filepath.FromSlash(pattern), ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern (%s): %w", pattern, err)
+		}
+	}
+
+	keyPrefix := data.KeyPrefix.ValueString()
+	files := make(localDirectoryFiles)
+
+	err = filepath.WalkDir(sourceDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+
+		if len(includes) > 0 && !slices.ContainsFunc(includes, func(pattern string) bool { return matchDirectoryFile(pattern, rel) }) {
+			return nil
+		}
+
+		if slices.ContainsFunc(excludes, func(pattern string) bool { return matchDirectoryFile(pattern, rel) }) {
+			return nil
+		}
+
+		hash, err := hashFile(p)
+		if err != nil {
+			return err
+		}
+
+		file := localDirectoryFile{
+			contentType: mime.TypeByExtension(filepath.Ext(p)),
+			hash:        hash,
+			path:        p,
+		}
+
+		// The first matching rule wins.
+		for _, rule := range cacheControlRules {
+			if matchDirectoryFile(rule.Pattern.ValueString(), rel) {
+				file.cacheControl = rule.Value.ValueString()
+				break
+			}
+		}
+
+		files[keyPrefix+filepath.ToSlash(rel)] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source_dir (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func (data *directorySyncResourceModel) id() string {
+	return data.Bucket.ValueString() + "/" + data.KeyPrefix.ValueString()
+}
+
+// matchDirectoryFile reports whether the path relative to source_dir matches the slash-separated pattern.
+// Patterns without a '/' are also matched against the file's base name, so "*.html" matches files in any directory.
+func matchDirectoryFile(pattern, rel string) bool {
+	if ok, _ := filepath.Match(filepath.FromSlash(pattern), rel); ok {
+		return true
+	}
+
+	if !strings.Contains(pattern, "/") {
+		ok, _ := filepath.Match(pattern, filepath.Base(rel))
+		return ok
+	}
+
+	return false
+}
+
+// hashFile returns the unpadded base64-encoded SHA-256 digest of the file's contents.
+func hashFile(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return base64.RawStdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+type directorySyncResourceModel struct {
+	Bucket        types.String                                           `tfsdk:"bucket"`
+	CacheControl  fwtypes.ListNestedObjectValueOf[cacheControlRuleModel] `tfsdk:"cache_control"`
+	DeleteRemoved types.Bool                                             `tfsdk:"delete_removed"`
+	Exclude       fwtypes.SetValueOf[types.String]                       `tfsdk:"exclude"`
+	Files         types.Map                                              `tfsdk:"files"`
+	ID            types.String                                           `tfsdk:"id"`
+	Include       fwtypes.SetValueOf[types.String]                       `tfsdk:"include"`
+	KeyPrefix     types.String                                           `tfsdk:"key_prefix"`
+	SourceDir     types.String                                           `tfsdk:"source_dir"`
+}
+
+type cacheControlRuleModel struct {
+	Pattern types.String `tfsdk:"pattern"`
+	Value   types.String `tfsdk:"value"`
+}
@@ -0,0 +1,355 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"js/app.js":       "console.log(1)",
+		"notes/draft.txt": "draft",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/js/app.js"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName+"/site/"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "site/index.html", "text/html; charset=utf-8", "max-age=60"),
+					testAccCheckDirectorySyncObject(ctx, resourceName, "site/css/site.css", "text/css; charset=utf-8", "max-age=31536000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+	var hash string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct2),
+					testAccCheckDirectorySyncFileHash(resourceName, "site/index.html", &hash),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFile(t, sourceDir, "index.html", "<html><body></body></html>")
+					testAccDirectorySyncWriteFile(t, sourceDir, "about.html", "<html></html>")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_deleteRemoved(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/about.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/css/site.css"),
+					resource.TestCheckResourceAttrWith(resourceName, "files.site/index.html", func(v string) error {
+						if v == hash {
+							return fmt.Errorf("expected index.html hash to change")
+						}
+						return nil
+					}),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_removedFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"js/app.js":       "console.log(1)",
+		"notes/draft.txt": "draft",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+				),
+			},
+			{
+				// Objects uploaded earlier are deleted once their local file is removed, even though delete_removed is false.
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(sourceDir, "js", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_removed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", acctest.Ct2),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/js/app.js"),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/js/app.js"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteRemovedKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectorySyncConfig_deleteRemovedKeyPrefix(rName, sourceDir, ""),
+				ExpectError: regexache.MustCompile(`key_prefix must end in '/' when delete_removed is true`),
+			},
+			{
+				Config:      testAccDirectorySyncConfig_deleteRemovedKeyPrefix(rName, sourceDir, "site"),
+				ExpectError: regexache.MustCompile(`key_prefix must end in '/' when delete_removed is true`),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		testAccDirectorySyncWriteFile(t, dir, name, content)
+	}
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccCheckDirectorySyncFileHash(n, key string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes["files."+key]
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObject(ctx context.Context, n, key, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) Content-Type = %q, want %q", key, got, contentType)
+		}
+
+		if got := aws.ToString(output.CacheControl); got != cacheControl {
+			return fmt.Errorf("S3 Object (%s) Cache-Control = %q, want %q", key, got, cacheControl)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object (%s) still exists", key)
+	}
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			bucket := rs.Primary.Attributes[names.AttrBucket]
+			err := tfs3.FindBucket(ctx, conn, bucket)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			output, err := conn.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
+				Bucket: aws.String(bucket),
+				Prefix: aws.String(rs.Primary.Attributes["key_prefix"]),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			if len(output.Contents) > 0 {
+				return fmt.Errorf("S3 Directory Sync %s objects still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[2]q
+
+  exclude = ["notes/*"]
+
+  cache_control {
+    pattern = "*.html"
+    value   = "max-age=60"
+  }
+
+  cache_control {
+    pattern = "*"
+    value   = "max-age=31536000"
+  }
+}
+`, rName, sourceDir)
+}
+
+func testAccDirectorySyncConfig_deleteRemoved(rName, sourceDir string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = "site/"
+  source_dir     = %[2]q
+  delete_removed = true
+}
+`, rName, sourceDir)
+}
+
+func testAccDirectorySyncConfig_deleteRemovedKeyPrefix(rName, sourceDir, keyPrefix string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket         = aws_s3_bucket.test.bucket
+  key_prefix     = %[3]q
+  source_dir     = %[2]q
+  delete_removed = true
+}
+`, rName, sourceDir, keyPrefix)
+}
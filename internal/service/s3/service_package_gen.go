@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDirectoryBucketResource,
 			Name:    "Directory Bucket",
 		},
+		{
+			Factory: newDirectorySyncResource,
+			Name:    "Directory Sync",
+		},
 	}
 }
 
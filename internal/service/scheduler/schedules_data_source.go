@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package scheduler
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_scheduler_schedules", name="Schedules")
+func dataSourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSchedulesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Schedules can't be tagged, so tags are matched against the schedule's group.
+			"group_tags": tftags.TagsSchema(),
+			names.AttrNamePrefix: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrState: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ScheduleState](),
+			},
+		},
+	}
+}
+
+func dataSourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // nosemgrep:ci.scheduler-in-func-name
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SchedulerClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &scheduler.ListSchedulesInput{}
+
+	if v, ok := d.GetOk("group_name"); ok {
+		input.GroupName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrNamePrefix); ok {
+		input.NamePrefix = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrState); ok {
+		input.State = types.ScheduleState(v.(string))
+	}
+
+	tagsToMatch := tftags.New(ctx, d.Get("group_tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	// Whether each schedule group has the tags to match.
+	groupMatches := make(map[string]bool)
+
+	var arns, scheduleNames []string
+
+	pages := scheduler.NewListSchedulesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EventBridge Scheduler Schedules: %s", err)
+		}
+
+		for _, v := range page.Schedules {
+			name := aws.ToString(v.Name)
+
+			if v, ok := d.GetOk("name_regex"); ok && !regexache.MustCompile(v.(string)).MatchString(name) {
+				continue
+			}
+
+			if len(tagsToMatch) > 0 {
+				groupName := aws.ToString(v.GroupName)
+				match, ok := groupMatches[groupName]
+
+				if !ok {
+					group, err := findScheduleGroupByName(ctx, conn, groupName)
+
+					if tfresource.NotFound(err) {
+						continue
+					}
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "reading EventBridge Scheduler Schedule Group (%s): %s", groupName, err)
+					}
+
+					tags, err := listTags(ctx, conn, aws.ToString(group.Arn))
+
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "listing tags for EventBridge Scheduler Schedule Group (%s): %s", groupName, err)
+					}
+
+					match = tags.ContainsAll(tagsToMatch)
+					groupMatches[groupName] = match
+				}
+
+				if !match {
+					continue
+				}
+			}
+
+			arns = append(arns, aws.ToString(v.Arn))
+			scheduleNames = append(scheduleNames, name)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	d.Set(names.AttrNames, scheduleNames)
+
+	return diags
+}
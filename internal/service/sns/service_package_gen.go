@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  dataSourceTopic,
 			TypeName: "aws_sns_topic",
 		},
+		{
+			Factory:  dataSourceTopics,
+			TypeName: "aws_sns_topics",
+			Name:     "Topics",
+		},
 	}
 }
 
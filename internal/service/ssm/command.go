@@ -0,0 +1,540 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Number of trailing characters of each output stream included in diagnostics.
+	commandOutputSnippetMaxLength = 1000
+)
+
+// @SDKResource("aws_ssm_command", name="Command")
+func resourceCommand() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCommandCreate,
+		ReadWithoutTimeout:   resourceCommandRead,
+		DeleteWithoutTimeout: resourceCommandDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrComment: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 100),
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"document_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([$]LATEST|[$]DEFAULT|^[1-9][0-9]*$)$`), ""),
+			},
+			"instance_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     50,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"max_concurrency": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"max_errors": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^([1-9][0-9]*|[0]|[1-9][0-9]%|[0-9]%|100%)$`), "must be a valid number (e.g. 10) or percentage including the percent sign (e.g. 10%)"),
+			},
+			"output_location": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrS3BucketName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 63),
+						},
+						names.AttrS3KeyPrefix: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(0, 500),
+						},
+						"s3_region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(3, 20),
+						},
+					},
+				},
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requested_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 163),
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 50,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+				ExactlyOneOf: []string{"instance_ids", "targets"},
+			},
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 2592000),
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	documentName := d.Get("document_name").(string)
+	input := &ssm.SendCommandInput{
+		DocumentName: aws.String(documentName),
+	}
+
+	if v, ok := d.GetOk(names.AttrComment); ok {
+		input.Comment = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("document_version"); ok {
+		input.DocumentVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("instance_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.InstanceIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("max_concurrency"); ok {
+		input.MaxConcurrency = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_errors"); ok {
+		input.MaxErrors = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("output_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+
+		input.OutputS3BucketName = aws.String(tfMap[names.AttrS3BucketName].(string))
+
+		if v, ok := tfMap[names.AttrS3KeyPrefix].(string); ok && v != "" {
+			input.OutputS3KeyPrefix = aws.String(v)
+		}
+
+		if v, ok := tfMap["s3_region"].(string); ok && v != "" {
+			input.OutputS3Region = aws.String(v)
+		}
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("service_role_arn"); ok {
+		input.ServiceRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("targets"); ok {
+		input.Targets = expandTargets(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("timeout_seconds"); ok {
+		input.TimeoutSeconds = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := conn.SendCommand(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "sending SSM Command (%s): %s", documentName, err)
+	}
+
+	d.SetId(aws.ToString(output.Command.CommandId))
+
+	if _, err := waitCommandSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for SSM Command (%s) success: %s", d.Id(), err)
+
+		// Report the failed invocations so that the cause is visible without a trip to the console.
+		// An error here leaves the resource tainted, so the command is sent again on the next apply.
+		return append(diags, commandInvocationFailureDiagnostics(ctx, conn, d.Id())...)
+	}
+
+	return append(diags, resourceCommandRead(ctx, d, meta)...)
+}
+
+func resourceCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	command, err := findCommandByID(ctx, conn, d.Id())
+
+	// Command history is only kept for 30 days. Removing the resource once it expires would send the command again,
+	// so the last known state is kept instead.
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SSM Command %s not found, history may have expired; keeping last known state", d.Id())
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s): %s", d.Id(), err)
+	}
+
+	d.Set("command_id", command.CommandId)
+	d.Set(names.AttrComment, command.Comment)
+	d.Set("document_name", command.DocumentName)
+	d.Set("document_version", command.DocumentVersion)
+	d.Set("instance_ids", command.InstanceIds)
+	d.Set("max_concurrency", command.MaxConcurrency)
+	d.Set("max_errors", command.MaxErrors)
+	if err := d.Set("output_location", flattenCommandOutputLocation(command)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting output_location: %s", err)
+	}
+	if err := d.Set(names.AttrParameters, flattenParameters(command.Parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+	if command.RequestedDateTime != nil {
+		d.Set("requested_date_time", aws.ToTime(command.RequestedDateTime).Format(time.RFC3339))
+	}
+	d.Set("service_role_arn", command.ServiceRole)
+	d.Set(names.AttrStatus, command.Status)
+	if err := d.Set("targets", flattenTargets(command.Targets)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting targets: %s", err)
+	}
+	d.Set("timeout_seconds", aws.ToInt32(command.TimeoutSeconds))
+
+	return diags
+}
+
+func resourceCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Commands that have run cannot be undone or deleted.
+	log.Printf("[DEBUG] Removing SSM Command %s from state", d.Id())
+
+	return diags
+}
+
+func findCommandByID(ctx context.Context, conn *ssm.Client, id string) (*awstypes.Command, error) {
+	input := &ssm.ListCommandsInput{
+		CommandId: aws.String(id),
+	}
+
+	output, err := findCommands(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findCommands(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandsInput) ([]awstypes.Command, error) {
+	var output []awstypes.Command
+
+	pages := ssm.NewListCommandsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Commands...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationsByCommandID(ctx context.Context, conn *ssm.Client, commandID string) ([]awstypes.CommandInvocation, error) {
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(commandID),
+		Details:   true,
+	}
+
+	return findCommandInvocations(ctx, conn, input)
+}
+
+func findCommandInvocations(ctx context.Context, conn *ssm.Client, input *ssm.ListCommandInvocationsInput) ([]awstypes.CommandInvocation, error) {
+	var output []awstypes.CommandInvocation
+
+	pages := ssm.NewListCommandInvocationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.InvalidCommandId](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CommandInvocations...)
+	}
+
+	return output, nil
+}
+
+func findCommandInvocationByThreePartKey(ctx context.Context, conn *ssm.Client, commandID, instanceID, pluginName string) (*ssm.GetCommandInvocationOutput, error) {
+	input := &ssm.GetCommandInvocationInput{
+		CommandId:  aws.String(commandID),
+		InstanceId: aws.String(instanceID),
+		PluginName: aws.String(pluginName),
+	}
+
+	output, err := conn.GetCommandInvocation(ctx, input)
+
+	if errs.IsA[*awstypes.InvalidCommandId](err) || errs.IsA[*awstypes.InvocationDoesNotExist](err) || errs.IsA[*awstypes.InvalidPluginName](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusCommand(ctx context.Context, conn *ssm.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findCommandByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitCommandSucceeded(ctx context.Context, conn *ssm.Client, id string, timeout time.Duration) (*awstypes.Command, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.CommandStatusPending, awstypes.CommandStatusInProgress, awstypes.CommandStatusCancelling),
+		Target:     enum.Slice(awstypes.CommandStatusSuccess),
+		Refresh:    statusCommand(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Command); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// commandInvocationFailureDiagnostics returns an error diagnostic for each plugin that did not succeed on each instance,
+// including the tail of its standard output and standard error.
+func commandInvocationFailureDiagnostics(ctx context.Context, conn *ssm.Client, commandID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	invocations, err := findCommandInvocationsByCommandID(ctx, conn, commandID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s) invocations: %s", commandID, err)
+	}
+
+	for _, invocation := range invocations {
+		if invocation.Status == awstypes.CommandInvocationStatusSuccess {
+			continue
+		}
+
+		instanceID := aws.ToString(invocation.InstanceId)
+		summary := fmt.Sprintf("SSM Command (%s) on instance %s: %s", commandID, instanceID, invocation.Status)
+
+		if len(invocation.CommandPlugins) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   aws.ToString(invocation.StatusDetails),
+			})
+
+			continue
+		}
+
+		for _, plugin := range invocation.CommandPlugins {
+			if plugin.Status == awstypes.CommandPluginStatusSuccess {
+				continue
+			}
+
+			pluginName := aws.ToString(plugin.Name)
+			detail := fmt.Sprintf("Step %s: %s (response code %d)", pluginName, aws.ToString(plugin.StatusDetails), plugin.ResponseCode)
+
+			// The output attached to the plugin mixes both streams and is truncated, so fetch them separately.
+			if output, err := findCommandInvocationByThreePartKey(ctx, conn, commandID, instanceID, pluginName); err == nil {
+				if v := aws.ToString(output.StandardOutputContent); v != "" {
+					detail += "\n\nStandard output:\n" + commandOutputSnippet(v)
+				}
+				if v := aws.ToString(output.StandardErrorContent); v != "" {
+					detail += "\n\nStandard error:\n" + commandOutputSnippet(v)
+				}
+			} else if v := aws.ToString(plugin.Output); v != "" {
+				detail += "\n\nOutput:\n" + commandOutputSnippet(v)
+			}
+
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   detail,
+			})
+		}
+	}
+
+	return diags
+}
+
+// commandOutputSnippet returns the end of s, where errors are usually reported.
+func commandOutputSnippet(s string) string {
+	s = strings.TrimSpace(s)
+
+	if len(s) <= commandOutputSnippetMaxLength {
+		return s
+	}
+
+	return "..." + s[len(s)-commandOutputSnippetMaxLength:]
+}
+
+func flattenCommandOutputLocation(apiObject *awstypes.Command) []interface{} {
+	if apiObject == nil || apiObject.OutputS3BucketName == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrS3BucketName: aws.ToString(apiObject.OutputS3BucketName),
+	}
+
+	if apiObject.OutputS3KeyPrefix != nil {
+		tfMap[names.AttrS3KeyPrefix] = aws.ToString(apiObject.OutputS3KeyPrefix)
+	}
+
+	if apiObject.OutputS3Region != nil {
+		tfMap["s3_region"] = aws.ToString(apiObject.OutputS3Region)
+	}
+
+	return []interface{}{tfMap}
+}
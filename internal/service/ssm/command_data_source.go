@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssm_command", name="Command")
+func dataSourceCommand() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCommandRead,
+
+		Schema: map[string]*schema.Schema{
+			"command_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrComment: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"document_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrInstanceID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"invocations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"plugins": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"output": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"response_code": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"standard_error_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"standard_output_url": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrStatus: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status_details": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"standard_error_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"standard_output_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requested_date_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	commandID := d.Get("command_id").(string)
+	command, err := findCommandByID(ctx, conn, commandID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("SSM Command", err))
+	}
+
+	input := &ssm.ListCommandInvocationsInput{
+		CommandId: aws.String(commandID),
+		Details:   true,
+	}
+
+	if v, ok := d.GetOk(names.AttrInstanceID); ok {
+		input.InstanceId = aws.String(v.(string))
+	}
+
+	invocations, err := findCommandInvocations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Command (%s) invocations: %s", commandID, err)
+	}
+
+	d.SetId(aws.ToString(command.CommandId))
+	d.Set(names.AttrComment, command.Comment)
+	d.Set("document_name", command.DocumentName)
+	d.Set("document_version", command.DocumentVersion)
+	if err := d.Set("invocations", flattenCommandInvocations(invocations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting invocations: %s", err)
+	}
+	if err := d.Set(names.AttrParameters, flattenParameters(command.Parameters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting parameters: %s", err)
+	}
+	if command.RequestedDateTime != nil {
+		d.Set("requested_date_time", aws.ToTime(command.RequestedDateTime).Format(time.RFC3339))
+	}
+	d.Set(names.AttrStatus, command.Status)
+	d.Set("status_details", command.StatusDetails)
+
+	return diags
+}
+
+func flattenCommandInvocations(apiObjects []awstypes.CommandInvocation) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrInstanceID:  aws.ToString(apiObject.InstanceId),
+			"instance_name":       aws.ToString(apiObject.InstanceName),
+			"plugins":             flattenCommandPlugins(apiObject.CommandPlugins),
+			"standard_error_url":  aws.ToString(apiObject.StandardErrorUrl),
+			"standard_output_url": aws.ToString(apiObject.StandardOutputUrl),
+			names.AttrStatus:      string(apiObject.Status),
+			"status_details":      aws.ToString(apiObject.StatusDetails),
+		})
+	}
+
+	return tfList
+}
+
+func flattenCommandPlugins(apiObjects []awstypes.CommandPlugin) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:        aws.ToString(apiObject.Name),
+			"output":              aws.ToString(apiObject.Output),
+			"response_code":       int(apiObject.ResponseCode),
+			"standard_error_url":  aws.ToString(apiObject.StandardErrorUrl),
+			"standard_output_url": aws.ToString(apiObject.StandardOutputUrl),
+			names.AttrStatus:      string(apiObject.Status),
+			"status_details":      aws.ToString(apiObject.StatusDetails),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMCommandDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ssm_command.test"
+	resourceName := "aws_ssm_command.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckCommandManagedInstanceRegistration(),
+			},
+			{
+				Config: testAccCommandDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "command_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "document_name", resourceName, "document_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "invocations.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invocations.0.instance_id", "aws_instance.test", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "invocations.0.plugins.#", acctest.Ct1),
+					resource.TestMatchResourceAttr(dataSourceName, "invocations.0.plugins.0.output", regexache.MustCompile(`^hello`)),
+					resource.TestCheckResourceAttr(dataSourceName, "invocations.0.plugins.0.response_code", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "invocations.0.status", string(awstypes.CommandInvocationStatusSuccess)),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, string(awstypes.CommandStatusSuccess)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCommandDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCommandConfig_basic(rName, "v1"), `
+data "aws_ssm_command" "test" {
+  command_id  = aws_ssm_command.test.id
+  instance_id = aws_instance.test.id
+}
+`)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMCommand_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var command awstypes.Command
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckCommandManagedInstanceRegistration(),
+			},
+			{
+				Config: testAccCommandConfig_basic(rName, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttrSet(resourceName, "command_id"),
+					resource.TestCheckResourceAttr(resourceName, "document_name", "AWS-RunShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "instance_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "requested_date_time"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.CommandStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "v1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			{
+				Config: testAccCommandConfig_basic(rName, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "v2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommand_targets(t *testing.T) {
+	ctx := acctest.Context(t)
+	var command awstypes.Command
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_command.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckCommandManagedInstanceRegistration(),
+			},
+			{
+				Config: testAccCommandConfig_targets(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCommandExists(ctx, resourceName, &command),
+					resource.TestCheckResourceAttr(resourceName, "max_concurrency", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "max_errors", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.CommandStatusSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "targets.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "targets.0.key", "tag:Name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMCommand_failure(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstancesDataSourceConfig_filterInstance(rName),
+				Check:  testAccCheckCommandManagedInstanceRegistration(),
+			},
+			{
+				Config:      testAccCommandConfig_failure(rName),
+				ExpectError: regexache.MustCompile(`(?s)on instance i-[0-9a-f]+: Failed.*Standard error:.*oops`),
+			},
+		},
+	})
+}
+
+func testAccCheckCommandManagedInstanceRegistration() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		log.Print("[DEBUG] Test: Sleep to allow SSM Agent to register EC2 instance as a managed node.")
+		time.Sleep(1 * time.Minute)
+		return nil
+	}
+}
+
+func testAccCheckCommandExists(ctx context.Context, n string, v *awstypes.Command) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		output, err := tfssm.FindCommandByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCommandConfig_basic(rName, release string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = "echo hello"
+  }
+
+  triggers = {
+    release = %[1]q
+  }
+}
+`, release))
+}
+
+func testAccCommandConfig_targets(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), fmt.Sprintf(`
+resource "aws_ssm_command" "test" {
+  document_name   = "AWS-RunShellScript"
+  max_concurrency = "1"
+  max_errors      = "0"
+
+  targets {
+    key    = "tag:Name"
+    values = [%[1]q]
+  }
+
+  parameters = {
+    commands = "echo hello"
+  }
+
+  depends_on = [aws_instance.test]
+}
+`, rName))
+}
+
+func testAccCommandConfig_failure(rName string) string {
+	return acctest.ConfigCompose(testAccInstancesDataSourceConfig_filterInstance(rName), `
+resource "aws_ssm_command" "test" {
+  document_name = "AWS-RunShellScript"
+  instance_ids  = [aws_instance.test.id]
+
+  parameters = {
+    commands = "echo oops >&2; exit 3"
+  }
+}
+`)
+}
@@ -7,6 +7,7 @@ package ssm
 var (
 	ResourceActivation              = resourceActivation
 	ResourceAssociation             = resourceAssociation
+	ResourceCommand                 = resourceCommand
 	ResourceDefaultPatchBaseline    = resourceDefaultPatchBaseline
 	ResourceDocument                = resourceDocument
 	ResourceMaintenanceWindow       = resourceMaintenanceWindow
@@ -20,6 +21,7 @@ var (
 
 	FindActivationByID                                 = findActivationByID
 	FindAssociationByID                                = findAssociationByID
+	FindCommandByID                                    = findCommandByID
 	FindDefaultPatchBaselineByOperatingSystem          = findDefaultPatchBaselineByOperatingSystem
 	FindDefaultDefaultPatchBaselineIDByOperatingSystem = findDefaultDefaultPatchBaselineIDByOperatingSystem
 	FindDocumentByName                                 = findDocumentByName
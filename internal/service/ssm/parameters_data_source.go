@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssm_parameters", name="Parameters")
+func dataSourceParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceParametersRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 132),
+						},
+						"option": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrNames: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrTags: tftags.TagsSchema(),
+			"types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ssm.DescribeParametersInput{}
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		input.ParameterFilters = expandParameterStringFilters(v.(*schema.Set).List())
+	}
+
+	// Tag filtering is done server-side using "tag:<key>" parameter filters.
+	tagsToMatch := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	for k, v := range tagsToMatch.Map() {
+		input.ParameterFilters = append(input.ParameterFilters, awstypes.ParameterStringFilter{
+			Key:    aws.String(fmt.Sprintf("tag:%s", k)),
+			Values: []string{v},
+		})
+	}
+
+	output, err := findParametersMetadata(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SSM Parameters: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, tfslices.ApplyToAll(output, func(v awstypes.ParameterMetadata) string {
+		return aws.ToString(v.ARN)
+	}))
+	d.Set(names.AttrNames, tfslices.ApplyToAll(output, func(v awstypes.ParameterMetadata) string {
+		return aws.ToString(v.Name)
+	}))
+	d.Set("types", tfslices.ApplyToAll(output, func(v awstypes.ParameterMetadata) awstypes.ParameterType {
+		return v.Type
+	}))
+
+	return diags
+}
+
+func expandParameterStringFilters(tfList []interface{}) []awstypes.ParameterStringFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.ParameterStringFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := expandParameterStringFilter(tfMap)
+
+		if apiObject == nil {
+			continue
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandParameterStringFilter(tfMap map[string]interface{}) *awstypes.ParameterStringFilter {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.ParameterStringFilter{}
+
+	if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap["option"].(string); ok && v != "" {
+		apiObject.Option = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrValues].([]interface{}); ok && len(v) > 0 {
+		apiObject.Values = flex.ExpandStringValueList(v)
+	}
+
+	return apiObject
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParametersDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_parameters.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "types.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMParametersDataSource_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_parameters.test"
+	resourceName := "aws_ssm_parameter.test.1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParametersDataSourceConfig_tags(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "names.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "types.0", "String"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParametersDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  count = 2
+
+  name  = "/%[1]s/param-${count.index}"
+  type  = "String"
+  value = "value-${count.index}"
+
+  tags = {
+    Name  = %[1]q
+    Index = count.index
+  }
+}
+`, rName)
+}
+
+func testAccParametersDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccParametersDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssm_parameters" "test" {
+  filter {
+    key    = "Path"
+    option = "OneLevel"
+    values = ["/%[1]s"]
+  }
+
+  depends_on = [aws_ssm_parameter.test]
+}
+`, rName))
+}
+
+func testAccParametersDataSourceConfig_tags(rName string) string {
+	return acctest.ConfigCompose(testAccParametersDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_ssm_parameters" "test" {
+  tags = {
+    Name  = %[1]q
+    Index = "1"
+  }
+
+  depends_on = [aws_ssm_parameter.test]
+}
+`, rName))
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ssm_patch_baselines", name="Patch Baselines")
+func dataSourcePatchBaselines() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataPatchBaselinesRead,
+
+		Schema: map[string]*schema.Schema{
+			"baseline_identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"baseline_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"baseline_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"baseline_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_baseline": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"operating_system": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"default_baselines": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrFilter: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValues: {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataPatchBaselinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SSMClient(ctx)
+
+	var filters []awstypes.PatchOrchestratorFilter
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		filters = expandPatchOrchestratorFilters(v.(*schema.Set).List())
+	}
+
+	defaultBaselines := d.Get("default_baselines").(bool)
+	var baselines []awstypes.PatchBaselineIdentity
+
+	pages := patchBaselinesPaginator(conn, filters...)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading SSM Patch Baselines: %s", err)
+		}
+
+		for _, baseline := range page.BaselineIdentities {
+			if defaultBaselines && !baseline.DefaultBaseline {
+				continue
+			}
+
+			baselines = append(baselines, baseline)
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("baseline_identities", flattenPatchBaselineIdentities(baselines)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting baseline_identities: %s", err)
+	}
+
+	return diags
+}
+
+func expandPatchOrchestratorFilters(tfList []interface{}) []awstypes.PatchOrchestratorFilter {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []awstypes.PatchOrchestratorFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PatchOrchestratorFilter{}
+
+		if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+			apiObject.Key = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrValues].([]interface{}); ok && len(v) > 0 {
+			apiObject.Values = flex.ExpandStringValueList(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenPatchBaselineIdentities(apiObjects []awstypes.PatchBaselineIdentity) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"baseline_description": aws.ToString(apiObject.BaselineDescription),
+			"baseline_id":          aws.ToString(apiObject.BaselineId),
+			"baseline_name":        aws.ToString(apiObject.BaselineName),
+			"default_baseline":     apiObject.DefaultBaseline,
+			"operating_system":     apiObject.OperatingSystem,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMPatchBaselinesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baselines.test"
+	resourceName := "aws_ssm_patch_baseline.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPatchBaselineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselinesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_description", resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.baseline_name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.default_baseline", "false"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "baseline_identities.0.operating_system", resourceName, "operating_system"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSSMPatchBaselinesDataSource_defaultBaselines(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ssm_patch_baselines.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPatchBaselinesDataSourceConfig_defaultBaselines(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "baseline_identities.#", 0),
+					resource.TestCheckResourceAttr(dataSourceName, "baseline_identities.0.default_baseline", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPatchBaselinesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_patch_baseline" "test" {
+  name             = %[1]q
+  description      = "Test patch baseline"
+  operating_system = "AMAZON_LINUX_2"
+
+  approved_patches = ["KB123456"]
+}
+
+data "aws_ssm_patch_baselines" "test" {
+  filter {
+    key    = "NAME_PREFIX"
+    values = [aws_ssm_patch_baseline.test.name]
+  }
+
+  filter {
+    key    = "OWNER"
+    values = ["Self"]
+  }
+}
+`, rName)
+}
+
+func testAccPatchBaselinesDataSourceConfig_defaultBaselines() string {
+	return `
+data "aws_ssm_patch_baselines" "test" {
+  default_baselines = true
+
+  filter {
+    key    = "OWNER"
+    values = ["AWS"]
+  }
+}
+`
+}
@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCommand,
+			TypeName: "aws_ssm_command",
+			Name:     "Command",
+		},
 		{
 			Factory:  dataSourceDocument,
 			TypeName: "aws_ssm_document",
@@ -44,6 +49,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ssm_parameter",
 			Name:     "Parameter",
 		},
+		{
+			Factory:  dataSourceParameters,
+			TypeName: "aws_ssm_parameters",
+			Name:     "Parameters",
+		},
 		{
 			Factory:  dataSourceParametersByPath,
 			TypeName: "aws_ssm_parameters_by_path",
@@ -54,6 +64,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ssm_patch_baseline",
 			Name:     "Patch Baseline",
 		},
+		{
+			Factory:  dataSourcePatchBaselines,
+			TypeName: "aws_ssm_patch_baselines",
+			Name:     "Patch Baselines",
+		},
 	}
 }
 
@@ -70,6 +85,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ssm_association",
 			Name:     "Association",
 		},
+		{
+			Factory:  resourceCommand,
+			TypeName: "aws_ssm_command",
+			Name:     "Command",
+		},
 		{
 			Factory:  resourceDefaultPatchBaseline,
 			TypeName: "aws_ssm_default_patch_baseline",
@@ -19,11 +19,17 @@ func NotFound(err error) bool {
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
 // Specifically, TimedOut returns true if the error matches all these conditions:
-//   - err is of type retry.TimeoutError
+//   - err is of type retry.TimeoutError or StateTimeoutError
 //   - TimeoutError.LastError is nil
 func TimedOut(err error) bool {
-	timeoutErr, ok := err.(*retry.TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
-	return ok && timeoutErr.LastError == nil
+	switch err := err.(type) { //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+	case *retry.TimeoutError:
+		return err.LastError == nil
+	case *StateTimeoutError:
+		return err.LastError == nil
+	}
+
+	return false
 }
 
 // SetLastError sets the LastError field on the error if supported.
@@ -35,6 +41,11 @@ func SetLastError(err, lastErr error) {
 			err.LastError = lastErr
 		}
 
+	case *StateTimeoutError:
+		if err.LastError == nil {
+			err.LastError = lastErr
+		}
+
 	case *retry.UnexpectedStateError:
 		if err.LastError == nil {
 			err.LastError = lastErr
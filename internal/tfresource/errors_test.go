@@ -84,6 +84,15 @@ func TestTimedOut(t *testing.T) {
 			Name: "timeout error non-nil last error",
 			Err:  &retry.TimeoutError{LastError: errors.New("test")},
 		},
+		{
+			Name:     "state timeout error",
+			Err:      &tfresource.StateTimeoutError{TimeoutError: &retry.TimeoutError{}},
+			Expected: true,
+		},
+		{
+			Name: "state timeout error non-nil last error",
+			Err:  &tfresource.StateTimeoutError{TimeoutError: &retry.TimeoutError{LastError: errors.New("test")}},
+		},
 		{
 			Name: "wrapped other error",
 			Err:  fmt.Errorf("test: %w", errors.New("test")),
@@ -148,6 +157,17 @@ func TestSetLastError(t *testing.T) {
 			Err:     &retry.TimeoutError{LastError: errors.New("test")},
 			LastErr: errors.New("lasttest"),
 		},
+		{
+			Name:     "state timeout error",
+			Err:      &tfresource.StateTimeoutError{TimeoutError: &retry.TimeoutError{}},
+			LastErr:  errors.New("lasttest"),
+			Expected: true,
+		},
+		{
+			Name:    "state timeout error non-nil last error no overwrite",
+			Err:     &tfresource.StateTimeoutError{TimeoutError: &retry.TimeoutError{LastError: errors.New("test")}},
+			LastErr: errors.New("lasttest"),
+		},
 		{
 			Name: "unexpected state error lastErr is nil",
 			Err:  &retry.UnexpectedStateError{},
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+const (
+	defaultProgressInterval = 1 * time.Minute
+	defaultTransitions      = 5
+)
+
+// StatusFunc returns the current value of a resource and its status.
+// A nil value indicates that the resource was not found.
+type StatusFunc[T any] func() (*T, string, error)
+
+// StateWaiter waits for a resource to reach one of its target statuses.
+// It behaves as retry.StateChangeConf, additionally logging progress and
+// describing the most recent status transitions in timeout errors.
+type StateWaiter[T any] struct {
+	Pending                   []string      // Statuses that may be observed while waiting.
+	Target                    []string      // Statuses to wait for. Empty to wait for the resource to be not found.
+	Refresh                   StatusFunc[T] // Returns the current value and status.
+	Timeout                   time.Duration // Time to wait before timing out.
+	Delay                     time.Duration // Wait this time before starting checks.
+	MinTimeout                time.Duration // Smallest time to wait before refreshes.
+	PollInterval              time.Duration // Override MinTimeout/backoff and only poll this often.
+	NotFoundChecks            int           // Number of times to allow not found when waiting for a target status.
+	ContinuousTargetOccurence int           // Number of times a target status has to occur continuously.
+
+	// Description identifies the resource in progress messages, e.g. "RDS DB Instance (my-db)".
+	Description string
+	// ProgressInterval is the minimum time between progress messages. Defaults to 1 minute.
+	ProgressInterval time.Duration
+	// Transitions is the number of most recent status transitions described in timeout errors. Defaults to 5.
+	Transitions int
+}
+
+// Apply sets the waiter's timing and not found options from Options.
+func (w *StateWaiter[T]) Apply(o Options) {
+	if o.Delay > 0 {
+		w.Delay = o.Delay
+	}
+
+	if o.MinPollInterval > 0 {
+		w.MinTimeout = o.MinPollInterval
+	}
+
+	if o.PollInterval > 0 {
+		w.PollInterval = o.PollInterval
+	}
+
+	if o.NotFoundChecks > 0 {
+		w.NotFoundChecks = o.NotFoundChecks
+	}
+
+	if o.ContinuousTargetOccurence > 0 {
+		w.ContinuousTargetOccurence = o.ContinuousTargetOccurence
+	}
+}
+
+// WaitForState waits for the resource to reach a target status and returns
+// its last observed value.
+// A timeout returns a *StateTimeoutError.
+func (w *StateWaiter[T]) WaitForState(ctx context.Context) (*T, error) {
+	p := &progress{
+		description: w.Description,
+		interval:    w.ProgressInterval,
+		limit:       w.Transitions,
+		start:       time.Now(),
+	}
+	if p.interval <= 0 {
+		p.interval = defaultProgressInterval
+	}
+	if p.limit <= 0 {
+		p.limit = defaultTransitions
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending: w.Pending,
+		Target:  w.Target,
+		Refresh: func() (interface{}, string, error) {
+			v, status, err := w.Refresh()
+
+			if err == nil {
+				p.observe(ctx, status, v == nil)
+			}
+
+			// Avoid returning a typed nil, which retry.StateChangeConf would treat as found.
+			if v == nil {
+				return nil, status, err
+			}
+
+			return v, status, err
+		},
+		Timeout:                   w.Timeout,
+		Delay:                     w.Delay,
+		MinTimeout:                w.MinTimeout,
+		PollInterval:              w.PollInterval,
+		NotFoundChecks:            w.NotFoundChecks,
+		ContinuousTargetOccurence: w.ContinuousTargetOccurence,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if timeoutErr, ok := err.(*retry.TimeoutError); ok { //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+		err = &StateTimeoutError{
+			TimeoutError: timeoutErr,
+			Transitions:  p.transitionsSnapshot(),
+		}
+	}
+
+	if output, ok := outputRaw.(*T); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// StatusTransition is an observed change in a resource's status.
+type StatusTransition struct {
+	Status  string        // The new status. Empty if the resource was not found.
+	Elapsed time.Duration // Time since waiting started.
+}
+
+func (t StatusTransition) String() string {
+	status := t.Status
+	if status == "" {
+		status = "(not found)"
+	}
+
+	return fmt.Sprintf("%s after %s", status, t.Elapsed.Round(time.Second))
+}
+
+// StateTimeoutError is returned by StateWaiter when waiting times out.
+// It wraps the retry.TimeoutError and describes the most recent status transitions.
+type StateTimeoutError struct {
+	*retry.TimeoutError
+	Transitions []StatusTransition
+}
+
+func (e *StateTimeoutError) Error() string {
+	if len(e.Transitions) == 0 {
+		return e.TimeoutError.Error()
+	}
+
+	transitions := make([]string, len(e.Transitions))
+	for i, t := range e.Transitions {
+		transitions[i] = t.String()
+	}
+
+	return fmt.Sprintf("%s; recent status transitions: %s", e.TimeoutError.Error(), strings.Join(transitions, ", "))
+}
+
+func (e *StateTimeoutError) Unwrap() error {
+	return e.TimeoutError
+}
+
+// progress tracks status transitions and periodically logs the last observed status.
+// Refreshes happen in a separate goroutine to the caller of WaitForState.
+type progress struct {
+	description string
+	interval    time.Duration
+	limit       int
+	start       time.Time
+
+	mu          sync.Mutex
+	observed    bool
+	lastStatus  string
+	lastLogged  time.Time
+	transitions []StatusTransition
+}
+
+func (p *progress) observe(ctx context.Context, status string, notFound bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	elapsed := now.Sub(p.start)
+	if notFound {
+		status = ""
+	}
+
+	fields := map[string]any{
+		"elapsed": elapsed.Round(time.Second).String(),
+		"status":  status,
+	}
+	if p.description != "" {
+		fields["resource"] = p.description
+	}
+
+	if !p.observed || status != p.lastStatus {
+		p.observed = true
+		p.lastStatus = status
+		p.transitions = append(p.transitions, StatusTransition{Status: status, Elapsed: elapsed})
+		if n := len(p.transitions); n > p.limit {
+			p.transitions = p.transitions[n-p.limit:]
+		}
+
+		tflog.Debug(ctx, "Status changed while waiting", fields)
+	}
+
+	if p.lastLogged.IsZero() {
+		// Don't log until the first interval has elapsed.
+		p.lastLogged = now
+	} else if now.Sub(p.lastLogged) >= p.interval {
+		p.lastLogged = now
+		tflog.Info(ctx, "Still waiting", fields)
+	}
+}
+
+func (p *progress) transitionsSnapshot() []StatusTransition {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]StatusTransition(nil), p.transitions...)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testWaiterResource struct {
+	Status string
+}
+
+func TestStateWaiter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Statuses      []string // Successive statuses. "" is not found.
+		Pending       []string
+		Target        []string
+		ExpectStatus  string
+		ExpectError   bool
+		ExpectTimeout bool
+		ExpectMessage string
+	}{
+		{
+			Name:         "target",
+			Statuses:     []string{"creating", "creating", "available"},
+			Pending:      []string{"creating"},
+			Target:       []string{"available"},
+			ExpectStatus: "available",
+		},
+		{
+			Name:     "not found",
+			Statuses: []string{"deleting", "deleting", ""},
+			Pending:  []string{"deleting"},
+			Target:   []string{},
+		},
+		{
+			Name:        "unexpected status",
+			Statuses:    []string{"creating", "failed"},
+			Pending:     []string{"creating"},
+			Target:      []string{"available"},
+			ExpectError: true,
+		},
+		{
+			Name:          "timeout",
+			Statuses:      []string{"creating", "backing-up", "modifying"},
+			Pending:       []string{"creating", "backing-up", "modifying"},
+			Target:        []string{"available"},
+			ExpectError:   true,
+			ExpectTimeout: true,
+			ExpectMessage: "recent status transitions: backing-up after",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+			ctx := acctest.Context(t)
+
+			var calls int32
+			w := &tfresource.StateWaiter[testWaiterResource]{
+				Pending: testCase.Pending,
+				Target:  testCase.Target,
+				Refresh: func() (*testWaiterResource, string, error) {
+					n := int(atomic.AddInt32(&calls, 1)) - 1
+					if n >= len(testCase.Statuses) {
+						n = len(testCase.Statuses) - 1
+					}
+					status := testCase.Statuses[n]
+					if status == "" {
+						return nil, "", nil
+					}
+					return &testWaiterResource{Status: status}, status, nil
+				},
+				Timeout:      2 * time.Second,
+				PollInterval: 10 * time.Millisecond,
+				Transitions:  2,
+			}
+
+			output, err := w.WaitForState(ctx)
+
+			if got, want := err != nil, testCase.ExpectError; got != want {
+				t.Fatalf("err = %v, want error %t", err, want)
+			}
+			if got, want := tfresource.TimedOut(err), testCase.ExpectTimeout; got != want {
+				t.Errorf("TimedOut = %t, want %t", got, want)
+			}
+			if testCase.ExpectMessage != "" && !strings.Contains(err.Error(), testCase.ExpectMessage) {
+				t.Errorf("error %q does not contain %q", err, testCase.ExpectMessage)
+			}
+			if testCase.ExpectStatus != "" && (output == nil || output.Status != testCase.ExpectStatus) {
+				t.Errorf("output = %v, want status %s", output, testCase.ExpectStatus)
+			}
+		})
+	}
+}
+
+func TestStateWaiterTimeoutTransitions(t *testing.T) {
+	t.Parallel()
+	ctx := acctest.Context(t)
+
+	var calls int32
+	statuses := []string{"creating", "backing-up", "modifying", "backing-up"}
+	w := &tfresource.StateWaiter[testWaiterResource]{
+		Pending: statuses,
+		Target:  []string{"available"},
+		Refresh: func() (*testWaiterResource, string, error) {
+			n := int(atomic.AddInt32(&calls, 1)) - 1
+			if n >= len(statuses) {
+				n = len(statuses) - 1
+			}
+			return &testWaiterResource{Status: statuses[n]}, statuses[n], nil
+		},
+		Timeout:      1 * time.Second,
+		PollInterval: 10 * time.Millisecond,
+	}
+
+	_, err := w.WaitForState(ctx)
+
+	var timeoutErr *tfresource.StateTimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("err = %v, want StateTimeoutError", err)
+	}
+
+	var got []string
+	for _, transition := range timeoutErr.Transitions {
+		got = append(got, transition.Status)
+	}
+	if want := statuses; strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("transitions = %v, want %v", got, want)
+	}
+	if got, want := timeoutErr.LastState, "backing-up"; got != want {
+		t.Errorf("LastState = %q, want %q", got, want)
+	}
+
+	tfresource.SetLastError(err, errors.New("status reason"))
+
+	if !strings.Contains(err.Error(), "status reason") {
+		t.Errorf("error %q does not contain last error", err)
+	}
+	if tfresource.TimedOut(err) {
+		t.Errorf("TimedOut = true with last error")
+	}
+}
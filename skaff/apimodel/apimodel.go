@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package apimodel reads the source of an AWS SDK for Go v2 service package
+// (input/output structures, nested types, enums, errors, paginators and
+// waiters) so that skaff can generate code which matches the real API.
+package apimodel
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	sdkModulePrefix = "github.com/aws/aws-sdk-go-v2/service/"
+	requiredMarker  = "This member is required."
+
+	// Prefix of the middleware which fills an operation's idempotency token.
+	idempotencyTokenPrefix = "idempotencyToken_initializeOp"
+)
+
+// Field is a single member of an API structure.
+type Field struct {
+	Name     string
+	Type     string // Go type expression as written in the SDK, e.g. "*string" or "[]types.Tag".
+	Required bool
+}
+
+// Struct is an API structure, either an operation's input/output or a member
+// of the service's types package.
+type Struct struct {
+	Name   string
+	Fields []*Field
+}
+
+// Field returns the named field or nil.
+func (s *Struct) Field(name string) *Field {
+	if s == nil {
+		return nil
+	}
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// Operation is an API operation.
+type Operation struct {
+	Name      string
+	Input     *Struct
+	Output    *Struct
+	Paginated bool
+	// IdempotencyToken is the input member which the SDK fills with an
+	// idempotency token if it is not set, e.g. "ClientToken". Empty if none.
+	IdempotencyToken string
+}
+
+// Enum is a string enumeration in the service's types package.
+type Enum struct {
+	Name   string
+	Values []string // Go constant names, e.g. "ExportStatusCodeHealthy".
+}
+
+// Service is the parsed model of an AWS SDK for Go v2 service package.
+type Service struct {
+	Package    string
+	Operations map[string]*Operation
+	Types      map[string]*Struct
+	Enums      map[string]*Enum
+	Errors     []string
+	Waiters    []string
+}
+
+// LocateModule returns the directory of the AWS SDK for Go v2 module for the
+// specified service package, as resolved by the provider's go.mod.
+func LocateModule(servicePackage string) (string, error) {
+	cmd := exec.Command("go", "list", "-m", "-f", "{{ .Dir }}", sdkModulePrefix+servicePackage)
+	cmd.Stderr = os.Stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating AWS SDK for Go v2 module (%s): %w; run skaff from within the provider module or specify the package directory with --sdk-dir", servicePackage, err)
+	}
+
+	dir := strings.TrimSpace(string(out))
+	if dir == "" {
+		return "", fmt.Errorf("locating AWS SDK for Go v2 module (%s): module not downloaded", servicePackage)
+	}
+
+	return dir, nil
+}
+
+// Load parses the AWS SDK for Go v2 service package in dir.
+func Load(dir string) (*Service, error) {
+	fset := token.NewFileSet()
+
+	pkg, err := parseDir(fset, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	typesPkg, err := parseDir(fset, filepath.Join(dir, "types"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Service{
+		Package:    pkg.name,
+		Operations: make(map[string]*Operation),
+		Types:      make(map[string]*Struct),
+		Enums:      make(map[string]*Enum),
+	}
+
+	structs := make(map[string]*Struct)
+	var paginators []string
+	idempotencyTokens := make(map[string]string)
+
+	for _, file := range pkg.files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						if st, ok := ts.Type.(*ast.StructType); ok {
+							structs[ts.Name.Name] = newStruct(ts.Name.Name, st, false)
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				name := decl.Name.Name
+				switch {
+				case decl.Recv != nil && receiverName(decl) == "Client" && decl.Name.IsExported():
+					s.Operations[name] = &Operation{Name: name}
+				case decl.Recv == nil && strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Paginator"):
+					paginators = append(paginators, strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Paginator"))
+				case decl.Recv == nil && strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Waiter"):
+					s.Waiters = append(s.Waiters, strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Waiter"))
+				case name == "HandleInitialize" && strings.HasPrefix(receiverName(decl), idempotencyTokenPrefix):
+					if member := idempotencyTokenMember(decl); member != "" {
+						idempotencyTokens[strings.TrimPrefix(receiverName(decl), idempotencyTokenPrefix)] = member
+					}
+				}
+			}
+		}
+	}
+
+	for name, op := range s.Operations {
+		op.Input = structs[name+"Input"]
+		op.Output = structs[name+"Output"]
+		if op.Input == nil || op.Output == nil {
+			delete(s.Operations, name)
+		}
+	}
+	for name, member := range idempotencyTokens {
+		if op, ok := s.Operations[name]; ok {
+			op.IdempotencyToken = member
+		}
+	}
+	for _, name := range paginators {
+		if op, ok := s.Operations[name]; ok {
+			op.Paginated = true
+		}
+	}
+
+	enumTypes := make(map[string]bool)
+	for _, file := range typesPkg.files {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						switch t := spec.Type.(type) {
+						case *ast.StructType:
+							s.Types[spec.Name.Name] = newStruct(spec.Name.Name, t, true)
+						case *ast.Ident:
+							if t.Name == "string" {
+								enumTypes[spec.Name.Name] = true
+							}
+						}
+					case *ast.ValueSpec:
+						if ident, ok := spec.Type.(*ast.Ident); ok {
+							for _, name := range spec.Names {
+								if e, ok := s.Enums[ident.Name]; ok {
+									e.Values = append(e.Values, name.Name)
+								} else {
+									s.Enums[ident.Name] = &Enum{Name: ident.Name, Values: []string{name.Name}}
+								}
+							}
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv != nil && decl.Name.Name == "ErrorCode" {
+					s.Errors = append(s.Errors, receiverName(decl))
+				}
+			}
+		}
+	}
+
+	for name := range s.Enums {
+		if !enumTypes[name] {
+			delete(s.Enums, name)
+		}
+	}
+	for name := range enumTypes {
+		if _, ok := s.Enums[name]; !ok {
+			s.Enums[name] = &Enum{Name: name}
+		}
+	}
+	// Error structures are not interesting as nested types.
+	for _, name := range s.Errors {
+		delete(s.Types, name)
+	}
+
+	sort.Strings(s.Errors)
+	sort.Strings(s.Waiters)
+
+	return s, nil
+}
+
+// Operation returns the first of the named operations which exists.
+func (s *Service) Operation(names ...string) *Operation {
+	for _, name := range names {
+		if op, ok := s.Operations[name]; ok {
+			return op
+		}
+	}
+	return nil
+}
+
+// IsEnum returns whether the specified types package type is a string enumeration.
+func (s *Service) IsEnum(name string) bool {
+	_, ok := s.Enums[name]
+	return ok
+}
+
+// IsError returns whether the specified types package type is an API error.
+func (s *Service) IsError(name string) bool {
+	for _, e := range s.Errors {
+		if e == name {
+			return true
+		}
+	}
+	return false
+}
+
+type parsedPackage struct {
+	name  string
+	files []*ast.File
+}
+
+func parseDir(fset *token.FileSet, dir string) (*parsedPackage, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading AWS SDK for Go v2 package (%s): %w", dir, err)
+	}
+
+	pkg := &parsedPackage{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing AWS SDK for Go v2 source (%s): %w", name, err)
+		}
+
+		pkg.name = file.Name.Name
+		pkg.files = append(pkg.files, file)
+	}
+
+	if len(pkg.files) == 0 {
+		return nil, errors.New("no Go source found in " + dir)
+	}
+
+	return pkg, nil
+}
+
+// newStruct returns the API members of a structure. Members of structures in
+// the types package refer to sibling types without qualification, so these are
+// qualified to read the same as references from the service package.
+func newStruct(name string, st *ast.StructType, inTypesPackage bool) *Struct {
+	s := &Struct{Name: name}
+
+	for _, field := range st.Fields.List {
+		// Embedded fields (e.g. noSmithyDocumentSerde) are not API members.
+		if len(field.Names) == 0 {
+			continue
+		}
+
+		required := field.Doc != nil && strings.Contains(field.Doc.Text(), requiredMarker)
+		for _, ident := range field.Names {
+			if !ident.IsExported() {
+				continue
+			}
+			s.Fields = append(s.Fields, &Field{
+				Name:     ident.Name,
+				Type:     typeExpr(field.Type, inTypesPackage),
+				Required: required,
+			})
+		}
+	}
+
+	return s
+}
+
+func typeExpr(expr ast.Expr, inTypesPackage bool) string {
+	if inTypesPackage {
+		expr = qualify(expr)
+	}
+
+	return types.ExprString(expr)
+}
+
+func qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if e.IsExported() {
+			return &ast.SelectorExpr{X: ast.NewIdent("types"), Sel: e}
+		}
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+	}
+
+	return expr
+}
+
+// idempotencyTokenMember returns the input member checked by an idempotency
+// token middleware, i.e. X in "if input.X == nil".
+func idempotencyTokenMember(decl *ast.FuncDecl) string {
+	var member string
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		expr, ok := n.(*ast.BinaryExpr)
+		if !ok || expr.Op != token.EQL || member != "" {
+			return member == ""
+		}
+		if nilIdent, ok := expr.Y.(*ast.Ident); !ok || nilIdent.Name != "nil" {
+			return true
+		}
+		if sel, ok := expr.X.(*ast.SelectorExpr); ok {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == "input" {
+				member = sel.Sel.Name
+			}
+		}
+
+		return member == ""
+	})
+
+	return member
+}
+
+func receiverName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := decl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
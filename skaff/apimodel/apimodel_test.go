@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func loadTestService(t *testing.T) *Service {
+	t.Helper()
+
+	s, err := Load(filepath.Join("testdata", "widgets"))
+	if err != nil {
+		t.Fatalf("loading test service: %s", err)
+	}
+
+	return s
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	s := loadTestService(t)
+
+	if got, want := s.Package, "widgets"; got != want {
+		t.Errorf("Package = %q, want %q", got, want)
+	}
+	if op := s.Operation("ListWidgets"); op == nil || !op.Paginated {
+		t.Errorf("ListWidgets not found or not paginated")
+	}
+	if op := s.Operation("GetWidget"); op == nil || op.Paginated {
+		t.Errorf("GetWidget not found or paginated")
+	}
+	if got, want := s.Waiters, []string{"WidgetActive"}; !slices.Equal(got, want) {
+		t.Errorf("Waiters = %v, want %v", got, want)
+	}
+	if !s.IsError("ResourceNotFoundException") {
+		t.Errorf("ResourceNotFoundException is not an error")
+	}
+	if _, ok := s.Types["ResourceNotFoundException"]; ok {
+		t.Errorf("ResourceNotFoundException is a nested type")
+	}
+	if e := s.Enums["WidgetStatus"]; e == nil || len(e.Values) != 3 {
+		t.Errorf("WidgetStatus enum = %v, want 3 values", e)
+	}
+
+	name := s.Operation("CreateWidget").Input.Field("Name")
+	if name == nil || !name.Required {
+		t.Errorf("CreateWidgetInput.Name not found or not required")
+	}
+	if f := s.Operation("CreateWidget").Input.Field("Description"); f == nil || f.Required {
+		t.Errorf("CreateWidgetInput.Description not found or required")
+	}
+	if got, want := s.Operation("CreateWidget").IdempotencyToken, "ClientToken"; got != want {
+		t.Errorf("CreateWidget idempotency token = %q, want %q", got, want)
+	}
+	if got, want := s.Operation("UpdateWidget").IdempotencyToken, ""; got != want {
+		t.Errorf("UpdateWidget idempotency token = %q, want %q", got, want)
+	}
+
+	// Types package members are qualified as they would be in the service package.
+	if got, want := s.Types["Widget"].Field("Status").Type, "types.WidgetStatus"; got != want {
+		t.Errorf("Widget.Status type = %q, want %q", got, want)
+	}
+	if got, want := s.Types["Widget"].Field("CreatedAt").Type, "*time.Time"; got != want {
+		t.Errorf("Widget.CreatedAt type = %q, want %q", got, want)
+	}
+}
+
+func TestResource(t *testing.T) {
+	t.Parallel()
+
+	s := loadTestService(t)
+
+	r, err := s.Resource("Widget")
+	if err != nil {
+		t.Fatalf("resolving resource: %s", err)
+	}
+
+	for _, v := range []struct {
+		name string
+		op   *Operation
+		want string
+	}{
+		{"Create", r.Create, "CreateWidget"},
+		{"Read", r.Read, "GetWidget"},
+		{"Update", r.Update, "UpdateWidget"},
+		{"Delete", r.Delete, "DeleteWidget"},
+		{"List", r.List, "ListWidgets"},
+	} {
+		if v.op == nil || v.op.Name != v.want {
+			t.Errorf("%s operation = %v, want %s", v.name, v.op, v.want)
+		}
+	}
+
+	if got, want := r.ShapeField, "Widget"; got != want {
+		t.Errorf("ShapeField = %q, want %q", got, want)
+	}
+	if got, want := r.StatusField, "Status"; got != want {
+		t.Errorf("StatusField = %q, want %q", got, want)
+	}
+	if got, want := r.NotFoundError, "ResourceNotFoundException"; got != want {
+		t.Errorf("NotFoundError = %q, want %q", got, want)
+	}
+	if got, want := r.TagsField, "Tags"; got != want {
+		t.Errorf("TagsField = %q, want %q", got, want)
+	}
+	if got, want := r.ListItemsField, "WidgetSummaries"; got != want {
+		t.Errorf("ListItemsField = %q, want %q", got, want)
+	}
+	if got, want := r.IdentifierIn(r.Create), []string{"Widget.Id"}; !slices.Equal(got, want) {
+		t.Errorf("IdentifierIn(Create) = %v, want %v", got, want)
+	}
+	if got, want := r.StatusValues("Creating"), []string{"WidgetStatusCreating"}; !slices.Equal(got, want) {
+		t.Errorf("StatusValues = %v, want %v", got, want)
+	}
+}
+
+func TestResourceModels(t *testing.T) {
+	t.Parallel()
+
+	s := loadTestService(t)
+
+	r, err := s.Resource("Widget")
+	if err != nil {
+		t.Fatalf("resolving resource: %s", err)
+	}
+
+	models := r.Models("widget")
+	if got, want := len(models), 2; got != want {
+		t.Fatalf("len(models) = %d, want %d", got, want)
+	}
+
+	top := models[0]
+	if got, want := top.TypeName, "resourceWidgetData"; got != want {
+		t.Errorf("TypeName = %q, want %q", got, want)
+	}
+	if !top.HasIDAttribute() {
+		t.Errorf("model has no id attribute")
+	}
+	if a := r.Attribute(top, "WidgetId"); a == nil || a.TFName != "id" {
+		t.Errorf("WidgetId attribute = %v, want id", a)
+	}
+
+	for _, v := range []struct {
+		member   string
+		name     string
+		tfName   string
+		kind     Kind
+		required bool
+		optional bool
+		computed bool
+		forceNew bool
+	}{
+		{"Arn", "ARN", "arn", KindString, false, false, true, false},
+		{"CreatedAt", "CreatedAt", "created_at", KindTimestamp, false, false, true, false},
+		{"Description", "Description", "description", KindString, false, true, true, false},
+		{"Name", "Name", "name", KindString, true, false, false, true},
+		{"Settings", "Settings", "settings", KindNested, false, false, true, false},
+		{"Status", "Status", "status", KindEnum, false, false, true, false},
+	} {
+		a := top.attribute(v.member)
+		if a == nil {
+			t.Errorf("no attribute for %s", v.member)
+			continue
+		}
+		if a.Name != v.name || a.TFName != v.tfName || a.Kind != v.kind {
+			t.Errorf("%s = (%s, %s, %d), want (%s, %s, %d)", v.member, a.Name, a.TFName, a.Kind, v.name, v.tfName, v.kind)
+		}
+		if a.Required != v.required || a.Optional != v.optional || a.Computed != v.computed || a.ForceNew != v.forceNew {
+			t.Errorf("%s required/optional/computed/forceNew = %t/%t/%t/%t, want %t/%t/%t/%t", v.member,
+				a.Required, a.Optional, a.Computed, a.ForceNew, v.required, v.optional, v.computed, v.forceNew)
+		}
+	}
+
+	for _, member := range []string{"ClientToken", "Tags"} {
+		if top.attribute(member) != nil {
+			t.Errorf("unexpected attribute for %s", member)
+		}
+	}
+
+	// Recursive structures are not expanded.
+	nested := models[1]
+	if got, want := nested.TypeName, "widgetWidgetSettingsData"; got != want {
+		t.Errorf("nested TypeName = %q, want %q", got, want)
+	}
+	if a := nested.attribute("Nested"); a == nil || a.Kind != KindUnsupported {
+		t.Errorf("recursive Nested attribute = %v, want unsupported", a)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	t.Parallel()
+
+	for member, want := range map[string]string{
+		"Arn":          "ARN",
+		"KmsKeyId":     "KMSKeyID",
+		"SubnetIds":    "SubnetIDs",
+		"Description":  "Description",
+		"RoleArn":      "RoleARN",
+		"VpcEndpoints": "VPCEndpoints",
+	} {
+		if got := goName(member); got != want {
+			t.Errorf("goName(%q) = %q, want %q", member, got, want)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+const maxNestingDepth = 5
+
+// Kind is the Terraform Plugin Framework representation of an API member.
+type Kind int
+
+const (
+	KindUnsupported Kind = iota
+	KindString
+	KindInt64
+	KindBool
+	KindFloat64
+	KindTimestamp
+	KindEnum
+	KindStringList
+	KindStringMap
+	KindNested
+)
+
+// Attribute is a member of a generated model.
+type Attribute struct {
+	Name     string // Go field name in the model, e.g. "KMSKeyID".
+	Member   string // API member name, e.g. "KmsKeyId".
+	TFName   string
+	Kind     Kind
+	Enum     string // types package enumeration, for KindEnum.
+	Nested   *Model // for KindNested.
+	List     bool   // whether a KindNested member is a slice.
+	APIType  string
+	Required bool
+	Optional bool
+	Computed bool
+	ForceNew bool
+}
+
+// Model is a generated Terraform Plugin Framework model structure.
+type Model struct {
+	TypeName   string
+	Attributes []*Attribute
+}
+
+// Models builds the resource's top-level model and all nested models it
+// references. The top-level model is first.
+func (r *Resource) Models(typePrefix string) []*Model {
+	b := &modelBuilder{
+		resource:   r,
+		typePrefix: typePrefix,
+		models:     make(map[string]*Model),
+		building:   make(map[string]bool),
+	}
+
+	updatable := make(map[string]bool)
+	if r.Update != nil {
+		for _, f := range r.Update.Input.Fields {
+			updatable[f.Name] = true
+		}
+	}
+
+	top := &Model{TypeName: "resource" + r.Name + "Data"}
+	seen := make(map[string]*Attribute)
+
+	for _, f := range r.Create.Input.Fields {
+		if ignoredMembers[f.Name] || f.Name == r.TagsField {
+			continue
+		}
+		a := b.attribute(f, 0)
+		a.Required = f.Required
+		a.Optional = !f.Required
+		a.ForceNew = !updatable[f.Name]
+		top.Attributes = append(top.Attributes, a)
+		seen[f.Name] = a
+	}
+
+	for _, f := range r.Shape.Fields {
+		if ignoredMembers[f.Name] || f.Name == "Tags" {
+			continue
+		}
+		if a, ok := seen[f.Name]; ok {
+			// Arguments the API may default are also computed.
+			if a.Optional && a.Kind != KindNested {
+				a.Computed = true
+			}
+			continue
+		}
+		a := b.attribute(f, 0)
+		a.Computed = true
+		top.Attributes = append(top.Attributes, a)
+		seen[f.Name] = a
+	}
+
+	for _, id := range r.Identifiers {
+		if top.attribute(r.Aliases(id.Name)...) == nil {
+			a := b.attribute(id, 0)
+			a.Required = true
+			a.ForceNew = true
+			top.Attributes = append(top.Attributes, a)
+			seen[id.Name] = a
+		}
+	}
+
+	sortAttributes(top.Attributes)
+
+	models := []*Model{top}
+	for _, name := range b.order {
+		models = append(models, b.models[name])
+	}
+
+	return models
+}
+
+// HasIDAttribute returns whether the model already has an "id" attribute.
+func (m *Model) HasIDAttribute() bool {
+	for _, a := range m.Attributes {
+		if a.TFName == "id" {
+			return true
+		}
+	}
+	return false
+}
+
+// Attribute returns the attribute for the specified API member or nil.
+// Identifier members are matched by their unqualified names too.
+func (r *Resource) Attribute(m *Model, member string) *Attribute {
+	return m.attribute(r.Aliases(member)...)
+}
+
+func (m *Model) attribute(members ...string) *Attribute {
+	for _, member := range members {
+		for _, a := range m.Attributes {
+			if a.Member == member {
+				return a
+			}
+		}
+	}
+	return nil
+}
+
+type modelBuilder struct {
+	resource   *Resource
+	typePrefix string
+	models     map[string]*Model
+	order      []string
+	building   map[string]bool
+}
+
+func (b *modelBuilder) attribute(f *Field, depth int) *Attribute {
+	a := &Attribute{
+		Name:    goName(f.Name),
+		Member:  f.Name,
+		TFName:  convert.ToSnakeCase(f.Name, ""),
+		APIType: f.Type,
+	}
+
+	switch t := strings.TrimPrefix(f.Type, "*"); t {
+	case "string":
+		a.Kind = KindString
+	case "int32", "int64":
+		a.Kind = KindInt64
+	case "bool":
+		a.Kind = KindBool
+	case "float32", "float64":
+		a.Kind = KindFloat64
+	case "time.Time":
+		a.Kind = KindTimestamp
+	case "[]string":
+		a.Kind = KindStringList
+	case "map[string]string":
+		a.Kind = KindStringMap
+	default:
+		name := typeName(t)
+		switch {
+		case !strings.HasPrefix(strings.TrimLeft(t, "[]"), "types."):
+		case b.resource.service.IsEnum(name) && !strings.HasPrefix(t, "[]"):
+			a.Kind, a.Enum = KindEnum, name
+		case b.resource.service.IsEnum(name) && strings.HasPrefix(t, "[]") && !strings.HasPrefix(t, "[][]"):
+			a.Kind = KindStringList
+		case b.resource.service.Types[name] != nil && !strings.HasPrefix(t, "[][]") && depth < maxNestingDepth:
+			if m := b.model(name, depth+1); m != nil {
+				a.Kind, a.List, a.Nested = KindNested, strings.HasPrefix(t, "[]"), m
+			}
+		}
+	}
+
+	return a
+}
+
+// model returns the nested model for a types package structure, or nil for
+// recursive structures. Nested attributes are Required or Optional as in the
+// API; they are only rendered as such when the parent is an argument.
+func (b *modelBuilder) model(name string, depth int) *Model {
+	if b.building[name] {
+		return nil
+	}
+
+	typeName := b.typePrefix + name + "Data"
+	if m, ok := b.models[typeName]; ok {
+		return m
+	}
+
+	b.building[name] = true
+	defer delete(b.building, name)
+
+	m := &Model{TypeName: typeName}
+	b.models[typeName] = m
+	b.order = append(b.order, typeName)
+
+	for _, f := range b.resource.service.Types[name].Fields {
+		a := b.attribute(f, depth)
+		a.Required = f.Required
+		a.Optional = !f.Required
+		m.Attributes = append(m.Attributes, a)
+	}
+
+	sortAttributes(m.Attributes)
+
+	return m
+}
+
+func sortAttributes(attrs []*Attribute) {
+	sort.SliceStable(attrs, func(i, j int) bool {
+		return attrs[i].TFName < attrs[j].TFName
+	})
+}
+
+// goName returns the idiomatic Go name for an API member, e.g. "KMSKeyID" for "KmsKeyId".
+func goName(member string) string {
+	var sb strings.Builder
+
+	words := splitWords(member)
+	for _, w := range words {
+		switch w {
+		case "Arn", "Id", "Kms", "Url", "Uri", "Vpc", "Iam", "Ip", "Dns", "Sns", "Sqs":
+			sb.WriteString(strings.ToUpper(w))
+		case "Arns", "Ids", "Urls", "Uris", "Vpcs", "Ips":
+			sb.WriteString(strings.ToUpper(strings.TrimSuffix(w, "s")) + "s")
+		default:
+			sb.WriteString(w)
+		}
+	}
+
+	return sb.String()
+}
+
+func splitWords(s string) []string {
+	var words []string
+
+	start := 0
+	for i := 1; i < len(s); i++ {
+		if s[i] >= 'A' && s[i] <= 'Z' && s[i-1] >= 'a' && s[i-1] <= 'z' {
+			words = append(words, s[start:i])
+			start = i
+		}
+	}
+
+	return append(words, s[start:])
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apimodel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Members which are handled by the generated code itself rather than being
+// mapped to schema attributes.
+var ignoredMembers = map[string]bool{
+	"ClientRequestToken": true,
+	"ClientToken":        true,
+	"DryRun":             true,
+	"MaxResults":         true,
+	"NextToken":          true,
+	"ResultMetadata":     true,
+}
+
+// Resource is the set of operations and structures which together describe a
+// single resource type in an API.
+type Resource struct {
+	Name string
+
+	Create *Operation
+	Read   *Operation
+	Update *Operation
+	Delete *Operation
+	List   *Operation
+
+	// ShapeField is the member of the Read output holding the resource's
+	// description. Empty if the output itself describes the resource.
+	ShapeField string
+	Shape      *Struct
+
+	// Identifiers are the required members of the Read input.
+	Identifiers []*Field
+
+	// StatusField is a string enumeration member of the shape reporting the
+	// resource's lifecycle status, if any.
+	StatusField string
+	StatusEnum  *Enum
+
+	// NotFoundError is the types package error returned when the resource does not exist.
+	NotFoundError string
+
+	// TagsField is the Create input member used to tag on create.
+	TagsField string
+
+	// ListItemsField is the member of the List output holding the resource summaries.
+	ListItemsField string
+	ListItem       *Struct
+
+	service *Service
+}
+
+// Resource resolves the operations and structures for the named resource.
+func (s *Service) Resource(name string) (*Resource, error) {
+	r := &Resource{
+		Name:    name,
+		service: s,
+	}
+
+	r.Create = s.Operation("Create"+name, "Put"+name)
+	if r.Create == nil {
+		return nil, fmt.Errorf("no Create%[1]s or Put%[1]s operation found in %s", name, s.Package)
+	}
+
+	r.Read = s.Operation("Get"+name, "Describe"+name)
+	if r.Read == nil {
+		return nil, fmt.Errorf("no Get%[1]s or Describe%[1]s operation found in %s", name, s.Package)
+	}
+
+	r.Delete = s.Operation("Delete"+name, "Deregister"+name)
+	if r.Delete == nil {
+		return nil, fmt.Errorf("no Delete%[1]s operation found in %s", name, s.Package)
+	}
+
+	if r.Create.Name != "Put"+name {
+		r.Update = s.Operation("Update"+name, "Modify"+name, "Put"+name)
+	}
+
+	for _, plural := range plurals(name) {
+		if op := s.Operation("List"+plural, "Describe"+plural); op != nil && op.Paginated {
+			r.List = op
+			break
+		}
+	}
+
+	r.resolveShape()
+
+	for _, f := range r.Read.Input.Fields {
+		if !f.Required {
+			continue
+		}
+		if f.Type != "*string" && f.Type != "string" {
+			return nil, fmt.Errorf("%s identifier %s has unsupported type %s", r.Read.Name, f.Name, f.Type)
+		}
+		r.Identifiers = append(r.Identifiers, f)
+	}
+	if len(r.Identifiers) == 0 {
+		return nil, fmt.Errorf("%s has no required members to identify the resource", r.Read.Name)
+	}
+
+	for _, f := range r.Shape.Fields {
+		if !strings.HasSuffix(f.Name, "Status") {
+			continue
+		}
+		if e, ok := s.Enums[typeName(f.Type)]; ok && (f.Name == "Status" || f.Name == name+"Status") {
+			r.StatusField = f.Name
+			r.StatusEnum = e
+			break
+		}
+	}
+
+	for _, candidate := range []string{name + "NotFoundException", "ResourceNotFoundException", "NotFoundException", "NoSuch" + name} {
+		if s.IsError(candidate) {
+			r.NotFoundError = candidate
+			break
+		}
+	}
+
+	for _, candidate := range []string{"Tags", "ResourceTags"} {
+		if f := r.Create.Input.Field(candidate); f != nil {
+			r.TagsField = candidate
+			break
+		}
+	}
+
+	if r.List != nil {
+		for _, f := range r.List.Output.Fields {
+			if strings.HasPrefix(f.Type, "[]types.") {
+				item := s.Types[typeName(f.Type)]
+				if item != nil && r.hasIdentifiers(item) {
+					r.ListItemsField, r.ListItem = f.Name, item
+					break
+				}
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// IdentifierIn returns how each identifier can be read from the Create output,
+// e.g. "ExportArn" or "Export.ExportArn". Identifiers not present in the output
+// are returned as empty strings.
+func (r *Resource) IdentifierIn(op *Operation) []string {
+	paths := make([]string, len(r.Identifiers))
+
+	for i, id := range r.Identifiers {
+		if f := r.Member(op.Output, id.Name); f != nil && isString(f.Type) {
+			paths[i] = f.Name
+			continue
+		}
+		for _, f := range op.Output.Fields {
+			if nested := r.service.Types[typeName(f.Type)]; nested != nil && strings.HasPrefix(f.Type, "*") {
+				if nf := r.Member(nested, id.Name); nf != nil && isString(nf.Type) {
+					paths[i] = f.Name + "." + nf.Name
+					break
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
+// Member returns the structure's member corresponding to the specified member
+// of another structure or nil. Identifiers qualified by the resource name in
+// one structure are commonly unqualified in the resource's own structures,
+// e.g. "ProfileId" in GetProfileInput and "Id" in Profile.
+func (r *Resource) Member(s *Struct, name string) *Field {
+	for _, alias := range r.Aliases(name) {
+		if f := s.Field(alias); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// Aliases returns the member name followed by any equivalent member names.
+func (r *Resource) Aliases(name string) []string {
+	aliases := []string{name}
+
+	switch suffix := strings.TrimPrefix(name, r.Name); {
+	case suffix == name:
+	case suffix == "Id", suffix == "Arn", suffix == "Name":
+		aliases = append(aliases, suffix)
+	}
+	switch name {
+	case "Id", "Arn", "Name":
+		aliases = append(aliases, r.Name+name)
+	}
+
+	return aliases
+}
+
+// StatusValues returns the status enumeration's Go constant names whose
+// values contain any of the specified words.
+func (r *Resource) StatusValues(words ...string) []string {
+	if r.StatusEnum == nil {
+		return nil
+	}
+
+	var values []string
+	for _, v := range r.StatusEnum.Values {
+		suffix := strings.TrimPrefix(v, r.StatusEnum.Name)
+		for _, w := range words {
+			if strings.Contains(suffix, w) {
+				values = append(values, v)
+				break
+			}
+		}
+	}
+
+	return values
+}
+
+func (r *Resource) resolveShape() {
+	var candidates []*Field
+
+	for _, f := range r.Read.Output.Fields {
+		if !strings.HasPrefix(f.Type, "*types.") {
+			continue
+		}
+		if _, ok := r.service.Types[typeName(f.Type)]; !ok {
+			continue
+		}
+		switch tn := typeName(f.Type); {
+		case tn == r.Name:
+			r.ShapeField, r.Shape = f.Name, r.service.Types[tn]
+			return
+		case strings.HasPrefix(tn, r.Name):
+			candidates = append([]*Field{f}, candidates...)
+		default:
+			candidates = append(candidates, f)
+		}
+	}
+
+	if len(candidates) > 0 && (len(candidates) == 1 || strings.HasPrefix(typeName(candidates[0].Type), r.Name)) {
+		r.ShapeField, r.Shape = candidates[0].Name, r.service.Types[typeName(candidates[0].Type)]
+		return
+	}
+
+	r.Shape = r.Read.Output
+}
+
+func (r *Resource) hasIdentifiers(s *Struct) bool {
+	for _, id := range r.Identifiers {
+		if f := r.Member(s, id.Name); f == nil || f.Type != "*string" {
+			return false
+		}
+	}
+	return true
+}
+
+func plurals(name string) []string {
+	p := []string{name + "s", name + "es"}
+	if strings.HasSuffix(name, "y") {
+		p = append(p, strings.TrimSuffix(name, "y")+"ies")
+	}
+	return p
+}
+
+// typeName returns the bare name of the types package type referenced by the
+// type expression, e.g. "Tag" for "[]types.Tag".
+func typeName(expr string) string {
+	expr = strings.TrimLeft(expr, "*[]")
+	return strings.TrimPrefix(expr, "types.")
+}
+
+func isString(expr string) bool {
+	return expr == "*string" || expr == "string"
+}
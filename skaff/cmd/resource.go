@@ -16,13 +16,15 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	fromAPI       bool
+	sdkDir        string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
+		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags, fromAPI, sdkDir)
 	},
 }
 
@@ -35,4 +37,6 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().BoolVarP(&fromAPI, "from-api", "a", false, "generate a compilable resource, test and sweeper from the AWS Go SDK v2 API model")
+	resourceCmd.Flags().StringVar(&sdkDir, "sdk-dir", "", "directory of the AWS Go SDK v2 service package to read with --from-api (default: resolved by go list)")
 }
@@ -0,0 +1,682 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+	"github.com/hashicorp/terraform-provider-aws/skaff/convert"
+)
+
+//go:embed resourcefwapi.tmpl
+var resourceFrameworkAPITmpl string
+
+//go:embed resourcefwapitest.tmpl
+var resourceFrameworkAPITestTmpl string
+
+//go:embed sweepapi.tmpl
+var sweepAPITmpl string
+
+// APITemplateData is the data for templates generated from the AWS SDK for Go v2 API model.
+type APITemplateData struct {
+	TemplateData
+
+	SDKPackage     string
+	ResourcePrefix string
+	Imports        string
+
+	SchemaAttributes string
+	SchemaBlocks     string
+	Models           string
+	UnmappedMembers  []string
+
+	CreateOperation string
+	ReadOperation   string
+	UpdateOperation string
+	DeleteOperation string
+	ListOperation   string
+
+	ShapeField  string
+	FinderType  string
+	FinderName  string
+	IDParts     []APIIDPart
+	SeparateID  bool
+	DeleteInput []APIMember
+
+	NotFoundError    string
+	TagsField        string
+	IdempotencyToken string
+
+	UpdatableAttributes []string
+
+	StatusField          string
+	CreatePendingStates  []string
+	CreateTargetStates   []string
+	UpdatePendingStates  []string
+	DeletePendingStates  []string
+	ListItemsField       string
+	RequiredTestStrings  []string
+	RequiredTestUnknowns []string
+}
+
+// APIIDPart is one part of the resource's identifier.
+type APIIDPart struct {
+	Attribute  string // Model field name.
+	TFName     string
+	Member     string // Read input member.
+	ListMember string // List output item member, if any.
+	Param      string // Finder parameter name.
+	Pointer    bool   // Whether the Read input member is a *string.
+	CreatePath string // Path to the value in the Create output, if any.
+}
+
+// APIMember is an input member populated from a model attribute.
+type APIMember struct {
+	Member    string
+	Attribute string // Empty if no model attribute matches.
+	Pointer   bool
+}
+
+func (d APIIDPart) Value(receiver string) string {
+	return fmt.Sprintf("%s.%s.ValueString()", receiver, d.Attribute)
+}
+
+// createFromAPI generates a Plugin Framework resource, its acceptance test,
+// sweeper and documentation from the AWS SDK for Go v2 API model.
+func createFromAPI(td TemplateData, sdkDir string, force bool) error {
+	data, err := loadAPITemplateData(td, sdkDir)
+	if err != nil {
+		return err
+	}
+
+	f := fmt.Sprintf("%s.go", td.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceFrameworkAPITmpl, force, data); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", td.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceFrameworkAPITestTmpl, force, data); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if err = addExports(data); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	if data.ListItemsField != "" {
+		if _, err := os.Stat("sweep.go"); errors.Is(err, fs.ErrNotExist) {
+			if err = writeGoTemplate("sweep", "sweep.go", sweepAPITmpl, force, data); err != nil {
+				return fmt.Errorf("writing sweeper template: %w", err)
+			}
+		} else {
+			fmt.Printf("sweep.go already exists, add a sweeper for %s using %s manually\n", td.ProviderResourceName, data.ListOperation)
+		}
+	}
+
+	if _, err := os.Stat("tags_gen.go"); data.IncludeTags && errors.Is(err, fs.ErrNotExist) {
+		fmt.Printf("tags_gen.go does not exist, add tagging code generation to generate.go (see docs/resource-tagging.md)\n")
+	}
+
+	return nil
+}
+
+// loadAPITemplateData loads the API model of the resource from the AWS SDK for Go v2
+// service package in sdkDir, or the provider's version of the package if sdkDir is empty.
+func loadAPITemplateData(td TemplateData, sdkDir string) (*APITemplateData, error) {
+	sdkPackage, err := names.AWSGoV2Package(td.ServicePackage)
+	if err != nil || sdkPackage == "" {
+		sdkPackage = td.ServicePackage
+	}
+
+	if sdkDir == "" {
+		sdkDir, err = apimodel.LocateModule(sdkPackage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	svc, err := apimodel.Load(sdkDir)
+	if err != nil {
+		return nil, fmt.Errorf("loading API model: %w", err)
+	}
+
+	res, err := svc.Resource(td.Resource)
+	if err != nil {
+		return nil, fmt.Errorf("resolving API model for %s: %w", td.Resource, err)
+	}
+
+	return newAPITemplateData(td, sdkPackage, res)
+}
+
+func newAPITemplateData(td TemplateData, sdkPackage string, res *apimodel.Resource) (*APITemplateData, error) {
+	prefix := convert.ToLowercasePrefix(td.Resource)
+	models := res.Models(prefix)
+	top := models[0]
+
+	data := &APITemplateData{
+		TemplateData:    td,
+		SDKPackage:      sdkPackage,
+		ResourcePrefix:  prefix,
+		CreateOperation: res.Create.Name,
+		ReadOperation:   res.Read.Name,
+		DeleteOperation: res.Delete.Name,
+		NotFoundError:   res.NotFoundError,
+		TagsField:       res.TagsField,
+		ShapeField:      res.ShapeField,
+		StatusField:     res.StatusField,
+		ListItemsField:  res.ListItemsField,
+	}
+	data.IncludeTags = res.TagsField != ""
+	data.IdempotencyToken = res.Create.IdempotencyToken
+
+	if res.Update != nil {
+		data.UpdateOperation = res.Update.Name
+		for _, f := range res.Update.Input.Fields {
+			if a := res.Attribute(top, f.Name); a != nil && a.Kind != apimodel.KindUnsupported && (a.Required || a.Optional) {
+				data.UpdatableAttributes = append(data.UpdatableAttributes, a.Name)
+			}
+		}
+	}
+	if res.List != nil {
+		data.ListOperation = res.List.Name
+	}
+
+	if res.ShapeField != "" {
+		data.FinderType = "awstypes." + res.Shape.Name
+	} else {
+		data.FinderType = sdkPackage + "." + res.Read.Name + "Output"
+	}
+
+	switch n := len(res.Identifiers); n {
+	case 1:
+		data.FinderName = "find" + td.Resource + "ByID"
+	default:
+		data.FinderName = fmt.Sprintf("find%sBy%sPartKey", td.Resource, partCount(n))
+	}
+
+	createPaths := res.IdentifierIn(res.Create)
+	for i, id := range res.Identifiers {
+		a := res.Attribute(top, id.Name)
+		part := APIIDPart{
+			Attribute:  a.Name,
+			TFName:     a.TFName,
+			Member:     id.Name,
+			Param:      convert.ToLowercasePrefix(a.Name),
+			Pointer:    strings.HasPrefix(id.Type, "*"),
+			CreatePath: createPaths[i],
+		}
+		if res.ListItem != nil {
+			part.ListMember = res.Member(res.ListItem, id.Name).Name
+		}
+		if part.Param == "type" || part.Param == "id" && a.TFName != "id" {
+			part.Param += "Value"
+		}
+		if part.CreatePath == "" && !a.Required {
+			return nil, fmt.Errorf("unable to determine %s from %s output", id.Name, res.Create.Name)
+		}
+		data.IDParts = append(data.IDParts, part)
+	}
+	data.SeparateID = !top.HasIDAttribute()
+
+	for _, f := range res.Delete.Input.Fields {
+		if !f.Required {
+			continue
+		}
+		m := APIMember{Member: f.Name, Pointer: strings.HasPrefix(f.Type, "*")}
+		if a := res.Attribute(top, f.Name); a != nil && a.Kind == apimodel.KindString {
+			m.Attribute = a.Name
+		}
+		data.DeleteInput = append(data.DeleteInput, m)
+	}
+
+	if res.StatusField != "" {
+		data.CreateTargetStates = res.StatusValues("Active", "Available", "Ready", "Created", "Complete", "Healthy", "Prepared", "Succeeded", "Enabled", "InService")
+		if len(data.CreateTargetStates) > 0 {
+			data.CreatePendingStates = res.StatusValues("Creating", "Pending", "InProgress", "Provisioning", "Initializing", "Starting")
+			if res.Update != nil {
+				data.UpdatePendingStates = res.StatusValues("Updating", "Modifying", "Pending", "InProgress")
+			}
+		}
+		data.DeletePendingStates = res.StatusValues("Deleting")
+	}
+
+	for _, a := range top.Attributes {
+		if a.Required && a.Kind == apimodel.KindString {
+			data.RequiredTestStrings = append(data.RequiredTestStrings, a.TFName)
+		} else if a.Required {
+			data.RequiredTestUnknowns = append(data.RequiredTestUnknowns, a.TFName)
+		}
+	}
+
+	r := &renderer{imports: make(map[string]bool)}
+	data.SchemaAttributes, data.SchemaBlocks = r.schema(top.Attributes, true, 3)
+	for _, m := range models {
+		data.UnmappedMembers = append(data.UnmappedMembers, r.model(m, m == top, data)...)
+	}
+	data.Models = r.models.String()
+	data.Imports = r.importBlock(data)
+
+	return data, nil
+}
+
+func (d *APITemplateData) usesAWS() bool {
+	if d.IdempotencyToken != "" {
+		return true
+	}
+	for _, p := range d.IDParts {
+		if p.Pointer || p.CreatePath != "" && !p.Pointer {
+			return true
+		}
+	}
+	for _, m := range d.DeleteInput {
+		if m.Pointer && m.Attribute != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func partCount(n int) string {
+	switch n {
+	case 2:
+		return "Two"
+	case 3:
+		return "Three"
+	case 4:
+		return "Four"
+	default:
+		return fmt.Sprintf("%d", n)
+	}
+}
+
+type renderer struct {
+	imports map[string]bool
+	models  strings.Builder
+}
+
+const (
+	importAWSTypes      = "awstypes"
+	importTimetypes     = "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	importListValidator = "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	importValidator     = "github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	importFWTypes       = "fwtypes"
+	importTypes         = "github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (r *renderer) schema(attrs []*apimodel.Attribute, topLevel bool, indent int) (string, string) {
+	var attributes, blocks strings.Builder
+
+	for _, a := range attrs {
+		if a.Kind == apimodel.KindUnsupported {
+			continue
+		}
+
+		key := fmt.Sprintf("%q", a.TFName)
+		if topLevel {
+			key = names.ConstOrQuote(a.TFName)
+		}
+
+		if a.Kind == apimodel.KindNested && !a.Computed {
+			fmt.Fprintf(&blocks, "\n%s: %s,", key, r.block(a, indent))
+			continue
+		}
+
+		fmt.Fprintf(&attributes, "\n%s: %s,", key, r.attribute(a))
+	}
+
+	return attributes.String(), blocks.String()
+}
+
+func (r *renderer) attribute(a *apimodel.Attribute) string {
+	var kind, planModifier string
+	var fields []string
+
+	switch a.Kind {
+	case apimodel.KindString:
+		kind, planModifier = "String", "string"
+	case apimodel.KindEnum:
+		r.imports[importAWSTypes] = true
+		r.imports[importFWTypes] = true
+		kind, planModifier = "String", "string"
+		fields = append(fields, fmt.Sprintf("CustomType: fwtypes.StringEnumType[awstypes.%s]()", a.Enum))
+	case apimodel.KindTimestamp:
+		r.imports[importTimetypes] = true
+		kind, planModifier = "String", "string"
+		fields = append(fields, "CustomType: timetypes.RFC3339Type{}")
+	case apimodel.KindInt64:
+		kind, planModifier = "Int64", "int64"
+	case apimodel.KindBool:
+		kind, planModifier = "Bool", "bool"
+	case apimodel.KindFloat64:
+		kind, planModifier = "Float64", "float64"
+	case apimodel.KindStringList:
+		r.imports[importFWTypes] = true
+		kind, planModifier = "List", "list"
+		fields = append(fields, "CustomType: fwtypes.ListOfStringType", "ElementType: types.StringType")
+	case apimodel.KindStringMap:
+		r.imports[importFWTypes] = true
+		kind, planModifier = "Map", "map"
+		fields = append(fields, "CustomType: fwtypes.MapOfStringType", "ElementType: types.StringType")
+	case apimodel.KindNested:
+		r.imports[importFWTypes] = true
+		kind, planModifier = "List", "list"
+		fields = append(fields,
+			fmt.Sprintf("CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx)", a.Nested.TypeName),
+			fmt.Sprintf("ElementType: types.ObjectType{\nAttrTypes: fwtypes.AttributeTypesMust[%s](ctx),\n}", a.Nested.TypeName),
+		)
+	}
+
+	if a.Required {
+		fields = append(fields, "Required: true")
+	}
+	if a.Optional {
+		fields = append(fields, "Optional: true")
+	}
+	if a.Computed {
+		fields = append(fields, "Computed: true")
+	}
+
+	var modifiers []string
+	if a.Computed {
+		modifiers = append(modifiers, planModifier+"planmodifier.UseStateForUnknown()")
+	}
+	if a.ForceNew {
+		modifiers = append(modifiers, planModifier+"planmodifier.RequiresReplace()")
+	}
+	if len(modifiers) > 0 {
+		r.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"] = true
+		r.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/"+planModifier+"planmodifier"] = true
+		fields = append(fields, fmt.Sprintf("PlanModifiers: []planmodifier.%s{\n%s,\n}", kind, strings.Join(modifiers, ",\n")))
+	}
+
+	if kind == "List" || kind == "Map" {
+		r.imports[importTypes] = true
+	}
+
+	return fmt.Sprintf("schema.%sAttribute{\n%s,\n}", kind, strings.Join(fields, ",\n"))
+}
+
+func (r *renderer) block(a *apimodel.Attribute, indent int) string {
+	r.imports[importFWTypes] = true
+
+	fields := []string{fmt.Sprintf("CustomType: fwtypes.NewListNestedObjectTypeOf[%s](ctx)", a.Nested.TypeName)}
+
+	var validators []string
+	if a.Required {
+		validators = append(validators, "listvalidator.IsRequired()")
+	}
+	if !a.List {
+		validators = append(validators, "listvalidator.SizeAtMost(1)")
+	}
+	if len(validators) > 0 {
+		r.imports[importListValidator] = true
+		r.imports[importValidator] = true
+		fields = append(fields, fmt.Sprintf("Validators: []validator.List{\n%s,\n}", strings.Join(validators, ",\n")))
+	}
+
+	if a.ForceNew {
+		r.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"] = true
+		r.imports["github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"] = true
+		fields = append(fields, "PlanModifiers: []planmodifier.List{\nlistplanmodifier.RequiresReplace(),\n}")
+	}
+
+	attributes, blocks := r.schema(a.Nested.Attributes, false, indent+1)
+	object := "schema.NestedBlockObject{\n"
+	if attributes != "" {
+		object += fmt.Sprintf("Attributes: map[string]schema.Attribute{%s\n},\n", attributes)
+	}
+	if blocks != "" {
+		object += fmt.Sprintf("Blocks: map[string]schema.Block{%s\n},\n", blocks)
+	}
+	object += "}"
+	fields = append(fields, "NestedObject: "+object)
+
+	return fmt.Sprintf("schema.ListNestedBlock{\n%s,\n}", strings.Join(fields, ",\n"))
+}
+
+// model renders the model structure and returns any API members which could not be mapped.
+func (r *renderer) model(m *apimodel.Model, topLevel bool, data *APITemplateData) []string {
+	type field struct{ name, typ, tag string }
+	var fields []field
+	var unmapped []string
+
+	for _, a := range m.Attributes {
+		var typ string
+
+		switch a.Kind {
+		case apimodel.KindUnsupported:
+			unmapped = append(unmapped, fmt.Sprintf("%s.%s (%s)", strings.TrimSuffix(m.TypeName, "Data"), a.Member, a.APIType))
+			continue
+		case apimodel.KindString:
+			typ = "types.String"
+		case apimodel.KindEnum:
+			typ = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", a.Enum)
+		case apimodel.KindTimestamp:
+			r.imports[importTimetypes] = true
+			typ = "timetypes.RFC3339"
+		case apimodel.KindInt64:
+			typ = "types.Int64"
+		case apimodel.KindBool:
+			typ = "types.Bool"
+		case apimodel.KindFloat64:
+			typ = "types.Float64"
+		case apimodel.KindStringList:
+			r.imports[importFWTypes] = true
+			typ = "fwtypes.ListValueOf[types.String]"
+		case apimodel.KindStringMap:
+			r.imports[importFWTypes] = true
+			typ = "fwtypes.MapValueOf[types.String]"
+		case apimodel.KindNested:
+			r.imports[importFWTypes] = true
+			typ = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", a.Nested.TypeName)
+		}
+
+		fields = append(fields, field{a.Name, typ, a.TFName})
+	}
+
+	if topLevel {
+		if data.SeparateID {
+			fields = append(fields, field{"ID", "types.String", "id"})
+		}
+		if data.IncludeTags {
+			fields = append(fields, field{"Tags", "types.Map", "tags"}, field{"TagsAll", "types.Map", "tags_all"})
+		}
+		fields = append(fields, field{"Timeouts", "timeouts.Value", "timeouts"})
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].tag < fields[j].tag
+	})
+
+	r.imports[importTypes] = true
+
+	fmt.Fprintf(&r.models, "\ntype %s struct {\n", m.TypeName)
+	for _, f := range fields {
+		fmt.Fprintf(&r.models, "%s %s `tfsdk:%q`\n", f.name, f.typ, f.tag)
+	}
+	fmt.Fprintln(&r.models, "}")
+
+	return unmapped
+}
+
+func (r *renderer) importBlock(data *APITemplateData) string {
+	std := []string{"context", "errors", "time"}
+
+	other := []string{
+		"github.com/aws/aws-sdk-go-v2/service/" + data.SDKPackage,
+		"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts",
+		"github.com/hashicorp/terraform-plugin-framework/resource",
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema",
+		"github.com/hashicorp/terraform-provider-aws/internal/create",
+		"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag",
+		"github.com/hashicorp/terraform-provider-aws/internal/framework",
+		`fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"`,
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource",
+		"github.com/hashicorp/terraform-provider-aws/names",
+	}
+	if data.usesAWS() {
+		other = append(other, "github.com/aws/aws-sdk-go-v2/aws")
+	}
+	if data.ShapeField != "" {
+		r.imports[importAWSTypes] = true
+	}
+	if data.NotFoundError != "" {
+		r.imports[importAWSTypes] = true
+		other = append(other, "github.com/hashicorp/terraform-provider-aws/internal/errs")
+	}
+	if data.NotFoundError != "" || len(data.CreateTargetStates) > 0 || len(data.DeletePendingStates) > 0 {
+		other = append(other, "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry")
+	}
+	if len(data.CreateTargetStates) > 0 || len(data.DeletePendingStates) > 0 {
+		r.imports[importAWSTypes] = true
+		other = append(other, "github.com/hashicorp/terraform-provider-aws/internal/enum")
+	}
+	if data.SeparateID && len(data.IDParts) > 1 {
+		other = append(other, "github.com/hashicorp/terraform-provider-aws/internal/errs")
+		other = append(other, "github.com/hashicorp/terraform-provider-aws/internal/flex")
+		r.imports[importTypes] = true
+	}
+	if data.IdempotencyToken != "" {
+		other = append(other, `sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"`)
+	}
+	if data.IncludeTags {
+		other = append(other, `tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"`)
+	}
+	for path := range r.imports {
+		switch path {
+		case importAWSTypes:
+			other = append(other, fmt.Sprintf(`awstypes "github.com/aws/aws-sdk-go-v2/service/%s/types"`, data.SDKPackage))
+		case importFWTypes:
+			other = append(other, `fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"`)
+		default:
+			other = append(other, path)
+		}
+	}
+
+	quote := func(s string) string {
+		if strings.HasSuffix(s, `"`) {
+			return s
+		}
+		return fmt.Sprintf("%q", s)
+	}
+	unquoted := func(s string) string {
+		if i := strings.Index(s, `"`); i >= 0 {
+			return strings.Trim(s[i:], `"`)
+		}
+		return s
+	}
+
+	seen := make(map[string]bool)
+	var lines []string
+	for _, s := range other {
+		if !seen[s] {
+			seen[s] = true
+			lines = append(lines, s)
+		}
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		return unquoted(lines[i]) < unquoted(lines[j])
+	})
+	sort.Strings(std)
+
+	var sb strings.Builder
+	for _, s := range std {
+		fmt.Fprintf(&sb, "\t%q\n", s)
+	}
+	sb.WriteString("\n")
+	for _, s := range lines {
+		fmt.Fprintf(&sb, "\t%s\n", quote(s))
+	}
+
+	return sb.String()
+}
+
+func addExports(data *APITemplateData) error {
+	const filename = "exports_test.go"
+
+	b, err := os.ReadFile(filename)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	contents, err := renderExports(b, data)
+	if err != nil {
+		return fmt.Errorf("formatting %s: %w", filename, err)
+	}
+
+	return os.WriteFile(filename, contents, 0644)
+}
+
+// renderExports adds the resource's test exports to the contents of exports_test.go, which is empty if the file does not exist.
+func renderExports(b []byte, data *APITemplateData) ([]byte, error) {
+	exports := fmt.Sprintf("\tResource%[1]s = newResource%[1]s\n\t%[2]s = %[3]s\n", data.Resource, "F"+strings.TrimPrefix(data.FinderName, "f"), data.FinderName)
+
+	if len(b) == 0 {
+		b = []byte(fmt.Sprintf(`// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package %s
+
+// Exports for use in tests only.
+var (
+%s)
+`, data.ServicePackage, exports))
+	} else if strings.Contains(string(b), "Resource"+data.Resource+" ") {
+		return b, nil
+	} else if s := string(b); strings.Contains(s, "var (\n") {
+		b = []byte(strings.Replace(s, "var (\n", "var (\n"+exports, 1))
+	} else {
+		b = append(b, []byte(fmt.Sprintf("\nvar (\n%s)\n", exports))...)
+	}
+
+	return format.Source(b)
+}
+
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	contents, err := renderGoTemplate(templateName, tmpl, td)
+	if err != nil {
+		return fmt.Errorf("generating file (%s): %w", filename, err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// renderGoTemplate executes the template and formats the result as Go source.
+func renderGoTemplate(templateName, tmpl string, td any) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.Execute(&buffer, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	contents, err := format.Source(buffer.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting generated source: %s", err)
+	}
+
+	return contents, nil
+}
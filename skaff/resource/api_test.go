@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/skaff/apimodel"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestRenderFromAPI renders the API model templates for the fixture SDK
+// package and compares the output with the golden files in testdata.
+// Run with -update to regenerate them.
+func TestRenderFromAPI(t *testing.T) {
+	t.Parallel()
+
+	svc, err := apimodel.Load(filepath.Join("..", "apimodel", "testdata", "widgets"))
+	if err != nil {
+		t.Fatalf("loading API model: %s", err)
+	}
+
+	res, err := svc.Resource("Widget")
+	if err != nil {
+		t.Fatalf("resolving resource: %s", err)
+	}
+
+	td := TemplateData{
+		Resource:             "Widget",
+		ResourceLower:        "widget",
+		ResourceSnake:        "widget",
+		HumanFriendlyService: "Widgets",
+		ServicePackage:       "widgets",
+		Service:              "Widgets",
+		ServiceLower:         "widgets",
+		AWSServiceName:       "AWS Widgets",
+		AWSGoSDKV2:           true,
+		PluginFramework:      true,
+		HumanResourceName:    "Widget",
+		ProviderResourceName: "aws_widgets_widget",
+	}
+
+	data, err := newAPITemplateData(td, "widgets", res)
+	if err != nil {
+		t.Fatalf("building template data: %s", err)
+	}
+
+	for _, v := range []struct {
+		name   string
+		tmpl   string
+		golden string
+	}{
+		{"newres", resourceFrameworkAPITmpl, "widget.go.golden"},
+		{"restest", resourceFrameworkAPITestTmpl, "widget_test.go.golden"},
+		{"sweep", sweepAPITmpl, "sweep.go.golden"},
+	} {
+		t.Run(v.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Rendering also checks that the output is valid, formatted Go source.
+			got, err := renderGoTemplate(v.name, v.tmpl, data)
+			if err != nil {
+				t.Fatalf("rendering template: %s", err)
+			}
+
+			golden := filepath.Join("testdata", v.golden)
+			if *update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatalf("writing golden file: %s", err)
+				}
+			}
+
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("reading golden file: %s", err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("rendered %s does not match %s; run with -update to regenerate", v.name, golden)
+			}
+		})
+	}
+}
+
+// TestTypeCheckFromAPI renders resources from real AWS SDK for Go v2 service
+// packages into the provider's service packages and type checks them with go vet,
+// using an overlay so that the working tree is unchanged.
+func TestTypeCheckFromAPI(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping type check in short mode")
+	}
+
+	t.Parallel()
+
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, v := range []struct {
+		servicePackage string
+		resource       string
+	}{
+		// Tags, an update operation and existing test exports.
+		{"medialive", "CloudWatchAlarmTemplate"},
+		// A two-part identifier, status waiters and a new sweeper.
+		{"pcaconnectorad", "ServicePrincipalName"},
+	} {
+		t.Run(v.servicePackage, func(t *testing.T) {
+			t.Parallel()
+
+			td, err := newTemplateData(v.servicePackage, v.resource, "", true, false, true, true)
+			if err != nil {
+				t.Fatalf("building template data: %s", err)
+			}
+
+			data, err := loadAPITemplateData(td, "")
+			if err != nil {
+				t.Fatalf("loading API model: %s", err)
+			}
+
+			dir := filepath.Join(root, "internal", "service", v.servicePackage)
+			files := map[string][]byte{}
+
+			for _, v := range []struct {
+				name     string
+				tmpl     string
+				filename string
+			}{
+				{"newres", resourceFrameworkAPITmpl, td.ResourceSnake + ".go"},
+				{"restest", resourceFrameworkAPITestTmpl, td.ResourceSnake + "_test.go"},
+				{"sweep", sweepAPITmpl, "sweep.go"},
+			} {
+				filename := filepath.Join(dir, v.filename)
+
+				if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) {
+					if v.name == "sweep" {
+						continue
+					}
+					t.Fatalf("%s already exists, choose a resource that the provider does not implement", filename)
+				}
+
+				if v.name == "sweep" && data.ListItemsField == "" {
+					continue
+				}
+
+				files[filename], err = renderGoTemplate(v.name, v.tmpl, data)
+				if err != nil {
+					t.Fatalf("rendering %s: %s", v.name, err)
+				}
+			}
+
+			filename := filepath.Join(dir, "exports_test.go")
+			b, err := os.ReadFile(filename)
+			if err != nil && !errors.Is(err, fs.ErrNotExist) {
+				t.Fatal(err)
+			}
+			if files[filename], err = renderExports(b, data); err != nil {
+				t.Fatalf("rendering exports: %s", err)
+			}
+
+			tmp := t.TempDir()
+			overlay := struct {
+				Replace map[string]string
+			}{
+				Replace: map[string]string{},
+			}
+			for filename, contents := range files {
+				path := filepath.Join(tmp, filepath.Base(filename))
+				if err := os.WriteFile(path, contents, 0644); err != nil {
+					t.Fatal(err)
+				}
+				overlay.Replace[filename] = path
+			}
+
+			b, err = json.Marshal(overlay)
+			if err != nil {
+				t.Fatal(err)
+			}
+			overlayFile := filepath.Join(tmp, "overlay.json")
+			if err := os.WriteFile(overlayFile, b, 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			// go vet type checks the package's test files as well as its sources.
+			cmd := exec.Command("go", "vet", "-overlay", overlayFile, "./internal/service/"+v.servicePackage)
+			cmd.Dir = root
+
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("type checking generated %s: %s\n%s", td.ProviderResourceName, err, out)
+			}
+		})
+	}
+}